@@ -0,0 +1,41 @@
+package once
+
+import "time"
+
+// Clock is a source of time for the time-based primitives.
+// It can be replaced with a fake in tests using [WithClock].
+type Clock interface {
+	Now() time.Time
+	AfterFunc(d time.Duration, f func()) Timer
+}
+
+type Timer interface {
+	Stop() bool
+}
+
+var _ Clock = realClock{}
+
+type realClock struct{}
+
+func (realClock) Now() time.Time                            { return time.Now() }
+func (realClock) AfterFunc(d time.Duration, f func()) Timer { return time.AfterFunc(d, f) }
+
+type timerOpts struct {
+	clock Clock
+}
+
+type TimerOpt func(*timerOpts)
+
+func WithClock(clock Clock) TimerOpt {
+	return func(t *timerOpts) {
+		t.clock = clock
+	}
+}
+
+func newTimerOpts(opts []TimerOpt) timerOpts {
+	t := timerOpts{clock: realClock{}}
+	for _, opt := range opts {
+		opt(&t)
+	}
+	return t
+}
@@ -0,0 +1,103 @@
+package once
+
+import (
+	"sync"
+	"time"
+)
+
+type Cooldown interface {
+	// Bool returns true if the cooldown has never fired or the interval has passed
+	// since it last fired.
+	Bool() bool
+
+	// Reset arms the cooldown immediately, regardless of the interval.
+	Reset()
+
+	Run(func())
+	Go(func())
+}
+
+var _ Cooldown = (*cooldownImpl)(nil)
+
+type cooldownImpl struct {
+	mu       sync.Mutex
+	interval time.Duration
+	clock    Clock
+	fired    bool
+	last     time.Time
+}
+
+func (c *cooldownImpl) Bool() bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	now := c.clock.Now()
+	if !c.fired || now.Sub(c.last) >= c.interval {
+		c.fired = true
+		c.last = now
+		return true
+	}
+	return false
+}
+
+func (c *cooldownImpl) Reset() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.fired = false
+}
+
+func (c *cooldownImpl) Run(f func()) {
+	if c.Bool() {
+		f()
+	}
+}
+
+func (c *cooldownImpl) Go(f func()) {
+	if c.Bool() {
+		go f()
+	}
+}
+
+func NewCooldown(interval time.Duration, opts ...TimerOpt) Cooldown {
+	t := newTimerOpts(opts)
+	return &cooldownImpl{interval: interval, clock: t.clock}
+}
+
+// CooldownMap keeps one cooldown per name. Cooldowns are never dropped on their
+// own, so call Forget for names that are no longer used.
+type CooldownMap interface {
+	Get(string) Cooldown
+	BoolOnce(string) bool
+	ResetOnce(string)
+	RunOnce(string, func())
+	GoOnce(string, func())
+
+	// Forget drops the cooldown for the name. The next use of the name starts
+	// with an armed cooldown.
+	Forget(string)
+}
+
+var _ CooldownMap = (*cooldownMapImpl)(nil)
+
+type cooldownMapImpl struct {
+	interval  time.Duration
+	opts      []TimerOpt
+	cooldowns sync.Map // map[string]Cooldown
+}
+
+func (c *cooldownMapImpl) Get(name string) Cooldown {
+	if cd, ok := c.cooldowns.Load(name); ok {
+		return cd.(Cooldown)
+	}
+	cd, _ := c.cooldowns.LoadOrStore(name, NewCooldown(c.interval, c.opts...))
+	return cd.(Cooldown)
+}
+
+func (c *cooldownMapImpl) BoolOnce(name string) bool     { return c.Get(name).Bool() }
+func (c *cooldownMapImpl) ResetOnce(name string)         { c.Get(name).Reset() }
+func (c *cooldownMapImpl) RunOnce(name string, f func()) { c.Get(name).Run(f) }
+func (c *cooldownMapImpl) GoOnce(name string, f func())  { c.Get(name).Go(f) }
+func (c *cooldownMapImpl) Forget(name string)            { c.cooldowns.Delete(name) }
+
+func NewCooldownMap(interval time.Duration, opts ...TimerOpt) CooldownMap {
+	return &cooldownMapImpl{interval: interval, opts: opts}
+}
@@ -0,0 +1,92 @@
+package once
+
+import (
+	"testing"
+	"time"
+)
+
+func TestCooldownRearmsAtInterval(t *testing.T) {
+	clock := newFakeClock()
+	c := NewCooldown(time.Second, WithClock(clock))
+
+	if !c.Bool() {
+		t.Fatal("first Bool = false")
+	}
+	clock.Advance(time.Second - 1)
+	if c.Bool() {
+		t.Fatal("Bool = true before the interval passed")
+	}
+	clock.Advance(1)
+	if !c.Bool() {
+		t.Fatal("Bool = false exactly at the interval")
+	}
+	if c.Bool() {
+		t.Fatal("Bool = true right after firing")
+	}
+}
+
+func TestCooldownReset(t *testing.T) {
+	clock := newFakeClock()
+	c := NewCooldown(time.Minute, WithClock(clock))
+
+	runs := 0
+	c.Run(func() { runs++ })
+	c.Run(func() { runs++ })
+	if runs != 1 {
+		t.Fatalf("ran %d times before Reset", runs)
+	}
+	c.Reset()
+	c.Run(func() { runs++ })
+	if runs != 2 {
+		t.Fatalf("ran %d times after Reset", runs)
+	}
+	// Firing after Reset starts a new interval.
+	clock.Advance(time.Minute - 1)
+	if c.Bool() {
+		t.Fatal("Bool = true before the interval passed since Reset")
+	}
+}
+
+func TestCooldownMapForget(t *testing.T) {
+	clock := newFakeClock()
+	m := NewCooldownMap(time.Minute, WithClock(clock))
+
+	if !m.BoolOnce("a") || m.BoolOnce("a") {
+		t.Fatal("cooldown for a not fired once")
+	}
+	if !m.BoolOnce("b") {
+		t.Fatal("cooldown for b shared with a")
+	}
+	m.Forget("a")
+	if !m.BoolOnce("a") {
+		t.Fatal("BoolOnce = false after Forget")
+	}
+	m.ResetOnce("b")
+	if !m.BoolOnce("b") {
+		t.Fatal("BoolOnce = false after ResetOnce")
+	}
+}
+
+func TestThrottlerLeadingEdge(t *testing.T) {
+	clock := newFakeClock()
+	th := NewThrottler(time.Second, WithClock(clock))
+
+	var got []int
+	for i := range 5 {
+		th.Throttle("k", func() { got = append(got, i) })
+		clock.Advance(300 * time.Millisecond)
+	}
+	// Calls at 0, 300, 600, 900, 1200ms: the first runs at once, the next one
+	// only after a full interval, and the dropped calls never run.
+	if len(got) != 2 || got[0] != 0 || got[1] != 4 {
+		t.Fatalf("ran %v, want [0 4]", got)
+	}
+	if !th.Throttle("other", func() {}) {
+		t.Fatal("names share a cooldown")
+	}
+
+	th.Forget("k")
+	if !th.Throttle("k", func() {}) {
+		t.Fatal("Throttle dropped the call after Forget")
+	}
+}
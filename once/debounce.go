@@ -0,0 +1,103 @@
+package once
+
+import (
+	"sync"
+	"time"
+)
+
+type Debouncer interface {
+	// Debounce schedules f to run after the interval has passed without another
+	// Debounce call for the same name (trailing edge). Only the last f is run.
+	Debounce(name string, f func())
+
+	// Cancel drops the pending call for the name, if any.
+	Cancel(name string)
+}
+
+var _ Debouncer = (*debouncerImpl)(nil)
+
+type debounceEntry struct {
+	timer Timer
+	gen   uint64
+}
+
+type debouncerImpl struct {
+	mu       sync.Mutex
+	interval time.Duration
+	clock    Clock
+	pending  map[string]*debounceEntry
+}
+
+func (d *debouncerImpl) Debounce(name string, f func()) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	e, ok := d.pending[name]
+	if ok {
+		e.timer.Stop()
+		e.gen++
+	} else {
+		e = &debounceEntry{}
+		d.pending[name] = e
+	}
+	gen := e.gen
+	e.timer = d.clock.AfterFunc(d.interval, func() {
+		d.mu.Lock()
+		// The timer may fire concurrently with Stop, so check that it is still current.
+		if cur, ok := d.pending[name]; !ok || cur != e || e.gen != gen {
+			d.mu.Unlock()
+			return
+		}
+		delete(d.pending, name)
+		d.mu.Unlock()
+		f()
+	})
+}
+
+func (d *debouncerImpl) Cancel(name string) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if e, ok := d.pending[name]; ok {
+		e.timer.Stop()
+		delete(d.pending, name)
+	}
+}
+
+func NewDebouncer(interval time.Duration, opts ...TimerOpt) Debouncer {
+	t := newTimerOpts(opts)
+	return &debouncerImpl{
+		interval: interval, clock: t.clock,
+		pending: make(map[string]*debounceEntry),
+	}
+}
+
+type Throttler interface {
+	// Throttle runs f immediately if it has not been run for the name within
+	// the interval (leading edge), and drops the call otherwise.
+	// It returns true if f was run.
+	Throttle(name string, f func()) bool
+
+	// Forget drops the state kept for the name, like [CooldownMap.Forget].
+	Forget(name string)
+}
+
+var _ Throttler = (*throttlerImpl)(nil)
+
+type throttlerImpl struct {
+	cooldowns CooldownMap
+}
+
+func (t *throttlerImpl) Throttle(name string, f func()) bool {
+	if t.cooldowns.BoolOnce(name) {
+		f()
+		return true
+	}
+	return false
+}
+
+func (t *throttlerImpl) Forget(name string) {
+	t.cooldowns.Forget(name)
+}
+
+func NewThrottler(interval time.Duration, opts ...TimerOpt) Throttler {
+	return &throttlerImpl{cooldowns: NewCooldownMap(interval, opts...)}
+}
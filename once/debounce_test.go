@@ -0,0 +1,57 @@
+package once
+
+import (
+	"testing"
+	"time"
+)
+
+func TestDebounceTrailingEdge(t *testing.T) {
+	clock := newFakeClock()
+	d := NewDebouncer(time.Second, WithClock(clock))
+
+	var got []int
+	for i := range 3 {
+		d.Debounce("k", func() { got = append(got, i) })
+		clock.Advance(500 * time.Millisecond)
+	}
+	if len(got) != 0 {
+		t.Fatalf("ran %v before the calls settled", got)
+	}
+	clock.Advance(499 * time.Millisecond)
+	if len(got) != 0 {
+		t.Fatalf("ran %v before the interval passed", got)
+	}
+	clock.Advance(time.Millisecond)
+	if len(got) != 1 || got[0] != 2 {
+		t.Fatalf("ran %v, want only the last call", got)
+	}
+
+	// A later call starts a new round.
+	d.Debounce("k", func() { got = append(got, 3) })
+	clock.Advance(time.Second)
+	if len(got) != 2 || got[1] != 3 {
+		t.Fatalf("ran %v after a second round", got)
+	}
+}
+
+func TestDebounceCancel(t *testing.T) {
+	clock := newFakeClock()
+	d := NewDebouncer(time.Second, WithClock(clock))
+
+	ran := map[string]bool{}
+	d.Debounce("a", func() { ran["a"] = true })
+	d.Debounce("b", func() { ran["b"] = true })
+	d.Cancel("a")
+	d.Cancel("missing")
+	clock.Advance(time.Second)
+	if ran["a"] || !ran["b"] {
+		t.Fatalf("ran %v, want only b", ran)
+	}
+
+	// The name is usable again after Cancel.
+	d.Debounce("a", func() { ran["a"] = true })
+	clock.Advance(time.Second)
+	if !ran["a"] {
+		t.Fatal("Debounce after Cancel did not run")
+	}
+}
@@ -0,0 +1,107 @@
+//go:build unix
+
+package once
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"syscall"
+)
+
+type FileOnce interface {
+	// Run runs f if the marker file does not exist and creates the marker if f succeeds.
+	// Run holds an exclusive flock on the lock file while checking the marker and running f,
+	// so f is run by at most one process at a time.
+	//
+	// If the lock holder crashes, the kernel releases the lock and the marker is not created,
+	// so the next caller runs f again.
+	Run(f func() error) error
+
+	// Fired reports whether the marker file exists.
+	Fired() (bool, error)
+
+	// Reset removes the marker file, so the next Run will run f again.
+	Reset() error
+}
+
+var _ FileOnce = (*fileOnceImpl)(nil)
+
+type fileOnceImpl struct {
+	lockPath   string
+	markerPath string
+}
+
+func (o *fileOnceImpl) lock() (*os.File, error) {
+	f, err := os.OpenFile(o.lockPath, os.O_RDWR|os.O_CREATE, 0o644)
+	if err != nil {
+		return nil, fmt.Errorf("open lock file: %w", err)
+	}
+	for {
+		err = syscall.Flock(int(f.Fd()), syscall.LOCK_EX)
+		if err != syscall.EINTR {
+			break
+		}
+	}
+	if err != nil {
+		f.Close()
+		return nil, fmt.Errorf("lock %s: %w", o.lockPath, err)
+	}
+	return f, nil
+}
+
+func unlock(f *os.File) {
+	syscall.Flock(int(f.Fd()), syscall.LOCK_UN)
+	f.Close()
+}
+
+func (o *fileOnceImpl) Run(f func() error) error {
+	l, err := o.lock()
+	if err != nil {
+		return err
+	}
+	defer unlock(l)
+
+	fired, err := o.Fired()
+	if err != nil || fired {
+		return err
+	}
+	if err := call(f); err != nil {
+		return err
+	}
+	return writeFileAtomic(o.markerPath, nil)
+}
+
+func (o *fileOnceImpl) Fired() (bool, error) {
+	_, err := os.Stat(o.markerPath)
+	if err == nil {
+		return true, nil
+	}
+	if errors.Is(err, fs.ErrNotExist) {
+		return false, nil
+	}
+	return false, fmt.Errorf("stat marker: %w", err)
+}
+
+func (o *fileOnceImpl) Reset() error {
+	l, err := o.lock()
+	if err != nil {
+		return err
+	}
+	defer unlock(l)
+
+	if err := os.Remove(o.markerPath); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return fmt.Errorf("remove marker: %w", err)
+	}
+	return nil
+}
+
+// NewFile returns a [FileOnce] that uses path+".lock" as the lock file and
+// path+".done" as the marker file. The parent directory must exist.
+//
+// Use a path inside a data directory to run once per data directory,
+// or a path like /var/lib/<app>/<task> to run once per machine.
+func NewFile(path string) FileOnce {
+	return &fileOnceImpl{lockPath: path + ".lock", markerPath: path + ".done"}
+}
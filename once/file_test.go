@@ -0,0 +1,154 @@
+//go:build unix
+
+package once
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestFileOnce(t *testing.T) {
+	o := NewFile(t.TempDir() + "/task")
+	if fired, err := o.Fired(); err != nil || fired {
+		t.Fatalf("Fired = %v, %v on a new path", fired, err)
+	}
+	runs := 0
+	run := func() error {
+		runs++
+		return nil
+	}
+	for range 2 {
+		if err := o.Run(run); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if runs != 1 {
+		t.Fatalf("ran %d times", runs)
+	}
+	if fired, err := o.Fired(); err != nil || !fired {
+		t.Fatalf("Fired = %v, %v after Run", fired, err)
+	}
+
+	if err := o.Reset(); err != nil {
+		t.Fatal(err)
+	}
+	if fired, _ := o.Fired(); fired {
+		t.Fatal("Fired = true after Reset")
+	}
+	if err := o.Run(run); err != nil || runs != 2 {
+		t.Fatalf("Run after Reset = %v, ran %d times", err, runs)
+	}
+	if err := o.Reset(); err != nil {
+		t.Fatal(err)
+	}
+	if err := o.Reset(); err != nil {
+		t.Fatalf("Reset without a marker: %v", err)
+	}
+}
+
+func TestFileOnceConcurrentInstances(t *testing.T) {
+	path := t.TempDir() + "/task"
+	var runs atomic.Int32
+	var wg sync.WaitGroup
+	for range 8 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			// Each instance opens its own lock file description, like another process would.
+			if err := NewFile(path).Run(func() error {
+				runs.Add(1)
+				return nil
+			}); err != nil {
+				t.Error(err)
+			}
+		}()
+	}
+	wg.Wait()
+	if n := runs.Load(); n != 1 {
+		t.Fatalf("ran %d times", n)
+	}
+}
+
+func TestFileOnceError(t *testing.T) {
+	o := NewFile(t.TempDir() + "/task")
+	errRun := errors.New("run failed")
+	if err := o.Run(func() error { return errRun }); !errors.Is(err, errRun) {
+		t.Fatalf("Run = %v", err)
+	}
+	if fired, err := o.Fired(); err != nil || fired {
+		t.Fatalf("Fired = %v, %v after a failed run", fired, err)
+	}
+	ran := false
+	if err := o.Run(func() error {
+		ran = true
+		return nil
+	}); err != nil || !ran {
+		t.Fatalf("Run after a failure = %v, ran = %v", err, ran)
+	}
+}
+
+const fileOnceHelperEnv = "ONCE_FILE_HELPER_PATH"
+
+// TestFileOnceHelperProcess is run as a subprocess by TestFileOnceDeadHolder.
+// It takes the lock and blocks until it is killed.
+func TestFileOnceHelperProcess(t *testing.T) {
+	path := os.Getenv(fileOnceHelperEnv)
+	if path == "" {
+		t.Skip("helper process")
+	}
+	NewFile(path).Run(func() error {
+		fmt.Println("locked")
+		select {}
+	})
+}
+
+func TestFileOnceDeadHolder(t *testing.T) {
+	path := t.TempDir() + "/task"
+	cmd := exec.Command(os.Args[0], "-test.run=^TestFileOnceHelperProcess$")
+	cmd.Env = append(os.Environ(), fileOnceHelperEnv+"="+path)
+	stdout, err := cmd.StdoutPipe()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := cmd.Start(); err != nil {
+		t.Fatal(err)
+	}
+	defer cmd.Process.Kill()
+	if line, err := bufio.NewReader(stdout).ReadString('\n'); err != nil || line != "locked\n" {
+		t.Fatalf("helper: %q, %v", line, err)
+	}
+
+	done := make(chan error, 1)
+	var ran atomic.Bool
+	go func() {
+		done <- NewFile(path).Run(func() error {
+			ran.Store(true)
+			return nil
+		})
+	}()
+	select {
+	case err := <-done:
+		t.Fatalf("Run returned while the helper holds the lock: %v", err)
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	if err := cmd.Process.Kill(); err != nil {
+		t.Fatal(err)
+	}
+	cmd.Wait()
+	select {
+	case err := <-done:
+		if err != nil || !ran.Load() {
+			t.Fatalf("Run after the holder died = %v, ran = %v", err, ran.Load())
+		}
+	case <-time.After(10 * time.Second):
+		t.Fatal("Run still blocked after the holder died")
+	}
+}
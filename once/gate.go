@@ -0,0 +1,58 @@
+package once
+
+import (
+	"context"
+	"sync"
+)
+
+type Gate interface {
+	// Open opens the gate and wakes every waiter. Subsequent calls do nothing.
+	Open()
+
+	IsOpen() bool
+
+	// Wait blocks until the gate is open or ctx is done.
+	Wait(ctx context.Context) error
+
+	// Done returns a chan that is closed when the gate is opened.
+	Done() <-chan struct{}
+}
+
+var _ Gate = (*gateImpl)(nil)
+
+type gateImpl struct {
+	once sync.Once
+	ch   chan struct{}
+}
+
+func (g *gateImpl) Open() {
+	g.once.Do(func() { close(g.ch) })
+}
+
+func (g *gateImpl) IsOpen() bool {
+	select {
+	case <-g.ch:
+		return true
+	default:
+		return false
+	}
+}
+
+func (g *gateImpl) Wait(ctx context.Context) error {
+	return waitChan(ctx, g.ch)
+}
+
+func (g *gateImpl) Done() <-chan struct{} { return g.ch }
+
+func NewGate() Gate {
+	return &gateImpl{ch: make(chan struct{})}
+}
+
+func waitChan(ctx context.Context, ch <-chan struct{}) error {
+	select {
+	case <-ch:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
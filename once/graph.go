@@ -0,0 +1,197 @@
+package once
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+)
+
+type Graph interface {
+	// Add registers a named init task with its dependencies.
+	// The dependencies do not have to be registered yet.
+	// If the task name already registered, Add returns an error.
+	Add(name string, f func(context.Context) error, deps ...string) error
+
+	// Run runs the task and all its dependencies. Each task is run at most once,
+	// after all its dependencies succeeded. Independent dependencies are run in parallel.
+	//
+	// If a task fails, Run returns a [*TaskError] for it. Tasks canceled by the context
+	// are armed again and run by the next Run.
+	Run(ctx context.Context, name string) error
+
+	// RunAll runs all registered tasks.
+	RunAll(ctx context.Context) error
+}
+
+type TaskError struct {
+	Name string
+	Err  error
+}
+
+func (e *TaskError) Error() string {
+	return fmt.Sprintf("task %q: %v", e.Name, e.Err)
+}
+
+func (e *TaskError) Unwrap() error {
+	return e.Err
+}
+
+var _ Graph = (*graphImpl)(nil)
+
+type graphTask struct {
+	f    func(context.Context) error
+	deps []string
+}
+
+type graphImpl struct {
+	mu    sync.RWMutex
+	tasks map[string]*graphTask
+	onces *onceMapImpl
+}
+
+func (g *graphImpl) Add(name string, f func(context.Context) error, deps ...string) error {
+	if f == nil {
+		return errors.New("func is nil")
+	}
+	if name == "" {
+		return errors.New("name is empty")
+	}
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	if _, ok := g.tasks[name]; ok {
+		return fmt.Errorf("task %q already exists", name)
+	}
+	g.tasks[name] = &graphTask{f: f, deps: deps}
+	return nil
+}
+
+// check verifies that the task and all its dependencies are registered and
+// that there are no cycles.
+func (g *graphImpl) check(name string) error {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+
+	const (
+		visiting = iota + 1
+		visited
+	)
+	state := make(map[string]int)
+	var path []string
+	var visit func(string) error
+	visit = func(name string) error {
+		switch state[name] {
+		case visited:
+			return nil
+		case visiting:
+			for i, v := range path {
+				if v == name {
+					return fmt.Errorf("dependency cycle: %s -> %s", strings.Join(path[i:], " -> "), name)
+				}
+			}
+		}
+		t, ok := g.tasks[name]
+		if !ok {
+			if len(path) == 0 {
+				return fmt.Errorf("task %q is not registered", name)
+			}
+			return fmt.Errorf("task %q depends on unregistered task %q", path[len(path)-1], name)
+		}
+		state[name] = visiting
+		path = append(path, name)
+		for _, dep := range t.deps {
+			if err := visit(dep); err != nil {
+				return err
+			}
+		}
+		path = path[:len(path)-1]
+		state[name] = visited
+		return nil
+	}
+	return visit(name)
+}
+
+func (g *graphImpl) task(name string) *graphTask {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+	return g.tasks[name]
+}
+
+func (g *graphImpl) run(ctx context.Context, name string) error {
+	t := g.task(name)
+	return g.onces.RunContextOnce(ctx, name, func(ctx context.Context) error {
+		if err := g.runDeps(ctx, t.deps); err != nil {
+			return err
+		}
+		if err := call(func() error { return t.f(ctx) }); err != nil {
+			return &TaskError{Name: name, Err: err}
+		}
+		return nil
+	})
+}
+
+// runDeps runs the dependencies in parallel and cancels the rest on the first error.
+func (g *graphImpl) runDeps(ctx context.Context, deps []string) error {
+	switch len(deps) {
+	case 0:
+		return nil
+	case 1:
+		return g.run(ctx, deps[0])
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	errs := make([]error, len(deps))
+	var wg sync.WaitGroup
+	for i, dep := range deps {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if errs[i] = g.run(ctx, dep); errs[i] != nil {
+				cancel()
+			}
+		}()
+	}
+	wg.Wait()
+
+	// Report the failed tasks, not the siblings canceled because of them.
+	var failed []error
+	for _, err := range errs {
+		if err != nil && !errors.Is(err, context.Canceled) {
+			failed = append(failed, err)
+		}
+	}
+	if len(failed) == 0 {
+		return errors.Join(errs...)
+	}
+	return errors.Join(failed...)
+}
+
+func (g *graphImpl) Run(ctx context.Context, name string) error {
+	if err := g.check(name); err != nil {
+		return err
+	}
+	return g.run(ctx, name)
+}
+
+func (g *graphImpl) RunAll(ctx context.Context) error {
+	g.mu.RLock()
+	deps := make([]string, 0, len(g.tasks))
+	for name := range g.tasks {
+		deps = append(deps, name)
+	}
+	g.mu.RUnlock()
+
+	for _, name := range deps {
+		if err := g.check(name); err != nil {
+			return err
+		}
+	}
+	return g.runDeps(ctx, deps)
+}
+
+func NewGraph() Graph {
+	return &graphImpl{tasks: make(map[string]*graphTask), onces: newMap()}
+}
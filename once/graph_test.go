@@ -0,0 +1,129 @@
+package once
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestGraphRunOrder(t *testing.T) {
+	g := NewGraph()
+	var mu sync.Mutex
+	runs := make(map[string]int)
+	var order []string
+	task := func(name string) func(context.Context) error {
+		return func(context.Context) error {
+			mu.Lock()
+			defer mu.Unlock()
+			runs[name]++
+			order = append(order, name)
+			return nil
+		}
+	}
+	g.Add("config", task("config"))
+	g.Add("proxy", task("proxy"), "config")
+	g.Add("db", task("db"), "config", "proxy")
+	g.Add("api", task("api"), "db", "proxy")
+
+	var wg sync.WaitGroup
+	for range 8 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if err := g.Run(context.Background(), "api"); err != nil {
+				t.Error(err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	for name, n := range runs {
+		if n != 1 {
+			t.Errorf("%s ran %d times", name, n)
+		}
+	}
+	if got := strings.Join(order, " "); got != "config proxy db api" {
+		t.Fatalf("order = %s", got)
+	}
+}
+
+func TestGraphRunParallel(t *testing.T) {
+	g := NewGraph()
+	var started sync.WaitGroup
+	started.Add(2)
+	// Each branch waits until the other one has started, so they must run in parallel.
+	branch := func(ctx context.Context) error {
+		started.Done()
+		done := make(chan struct{})
+		go func() {
+			started.Wait()
+			close(done)
+		}()
+		select {
+		case <-done:
+			return nil
+		case <-time.After(time.Second):
+			return errors.New("branches did not run in parallel")
+		}
+	}
+	g.Add("a", branch)
+	g.Add("b", branch)
+	g.Add("root", func(context.Context) error { return nil }, "a", "b")
+	if err := g.Run(context.Background(), "root"); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestGraphRunErrors(t *testing.T) {
+	errBad := errors.New("bad")
+	g := NewGraph()
+	noop := func(context.Context) error { return nil }
+	g.Add("config", noop)
+	g.Add("bad", func(context.Context) error { return errBad }, "config")
+	g.Add("api", noop, "bad", "config")
+	if err := g.Add("api", noop); err == nil {
+		t.Error("duplicate Add succeeded")
+	}
+
+	err := g.Run(context.Background(), "api")
+	var te *TaskError
+	if !errors.As(err, &te) || te.Name != "bad" || !errors.Is(err, errBad) {
+		t.Fatalf("Run = %v", err)
+	}
+
+	g.Add("c1", noop, "c2")
+	g.Add("c2", noop, "c3")
+	g.Add("c3", noop, "c1")
+	if err := g.Run(context.Background(), "c1"); err == nil || !strings.Contains(err.Error(), "c1 -> c2 -> c3 -> c1") {
+		t.Fatalf("cycle: %v", err)
+	}
+
+	g.Add("orphan", noop, "missing")
+	if err := g.Run(context.Background(), "orphan"); err == nil || !strings.Contains(err.Error(), `"missing"`) {
+		t.Fatalf("unregistered dependency: %v", err)
+	}
+}
+
+func TestGraphRunCanceledRearms(t *testing.T) {
+	g := NewGraph()
+	calls := 0
+	g.Add("probe", func(ctx context.Context) error {
+		calls++
+		if calls == 1 {
+			<-ctx.Done()
+			return ctx.Err()
+		}
+		return nil
+	})
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if err := g.Run(ctx, "probe"); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("first Run = %v", err)
+	}
+	if err := g.Run(context.Background(), "probe"); err != nil || calls != 2 {
+		t.Fatalf("second Run = %v, calls %d", err, calls)
+	}
+}
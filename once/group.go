@@ -0,0 +1,115 @@
+package once
+
+import (
+	"context"
+	"sync"
+)
+
+type Group[K comparable, V any] interface {
+	// Do runs fn for the key and returns its result. If a call for the key is already
+	// in flight, Do waits for it and returns the same result, with shared set to true.
+	//
+	// fn gets a context that is not canceled by the caller that started it. It is canceled
+	// only when every caller waiting for the result has abandoned the wait through its ctx.
+	Do(ctx context.Context, key K, fn func(context.Context) (V, error)) (v V, err error, shared bool)
+
+	// DoChan is like Do but returns a chan that receives the result.
+	DoChan(ctx context.Context, key K, fn func(context.Context) (V, error)) <-chan Result[V]
+
+	// Forget makes the next Do for the key run fn again instead of waiting for
+	// the call in flight.
+	Forget(key K)
+}
+
+type Result[V any] struct {
+	Val    V
+	Err    error
+	Shared bool
+}
+
+var _ Group[string, any] = (*groupImpl[string, any])(nil)
+
+type groupCall[V any] struct {
+	done    chan struct{}
+	cancel  context.CancelFunc
+	val     V
+	err     error
+	dups    int
+	waiters int
+}
+
+type groupImpl[K comparable, V any] struct {
+	mu    sync.Mutex
+	calls map[K]*groupCall[V]
+}
+
+func (g *groupImpl[K, V]) Do(ctx context.Context, key K, fn func(context.Context) (V, error)) (V, error, bool) {
+	g.mu.Lock()
+	if c, ok := g.calls[key]; ok {
+		c.dups++
+		c.waiters++
+		g.mu.Unlock()
+		return g.wait(ctx, key, c)
+	}
+	callCtx, cancel := context.WithCancel(context.WithoutCancel(ctx))
+	c := &groupCall[V]{done: make(chan struct{}), cancel: cancel, waiters: 1}
+	g.calls[key] = c
+	g.mu.Unlock()
+
+	go g.run(callCtx, key, c, fn)
+	return g.wait(ctx, key, c)
+}
+
+func (g *groupImpl[K, V]) run(ctx context.Context, key K, c *groupCall[V], fn func(context.Context) (V, error)) {
+	defer c.cancel()
+	c.err = call(func() (err error) {
+		c.val, err = fn(ctx)
+		return err
+	})
+
+	g.mu.Lock()
+	if g.calls[key] == c {
+		delete(g.calls, key)
+	}
+	g.mu.Unlock()
+	close(c.done)
+}
+
+func (g *groupImpl[K, V]) wait(ctx context.Context, key K, c *groupCall[V]) (v V, err error, shared bool) {
+	select {
+	case <-c.done:
+		g.mu.Lock()
+		defer g.mu.Unlock()
+		return c.val, c.err, c.dups > 0
+	case <-ctx.Done():
+		g.mu.Lock()
+		defer g.mu.Unlock()
+		c.waiters--
+		if c.waiters == 0 {
+			c.cancel()
+			if g.calls[key] == c {
+				delete(g.calls, key)
+			}
+		}
+		return v, ctx.Err(), false
+	}
+}
+
+func (g *groupImpl[K, V]) DoChan(ctx context.Context, key K, fn func(context.Context) (V, error)) <-chan Result[V] {
+	ch := make(chan Result[V], 1)
+	go func() {
+		v, err, shared := g.Do(ctx, key, fn)
+		ch <- Result[V]{Val: v, Err: err, Shared: shared}
+	}()
+	return ch
+}
+
+func (g *groupImpl[K, V]) Forget(key K) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	delete(g.calls, key)
+}
+
+func NewGroup[K comparable, V any]() Group[K, V] {
+	return &groupImpl[K, V]{calls: make(map[K]*groupCall[V])}
+}
@@ -0,0 +1,138 @@
+package once
+
+import (
+	"context"
+	"errors"
+	"runtime"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// waitWaiters waits until n callers are waiting for the call in flight for key.
+func waitWaiters[K comparable, V any](g Group[K, V], key K, n int) {
+	gi := g.(*groupImpl[K, V])
+	for {
+		gi.mu.Lock()
+		c, ok := gi.calls[key]
+		waiting := ok && c.waiters == n
+		gi.mu.Unlock()
+		if waiting {
+			return
+		}
+		runtime.Gosched()
+	}
+}
+
+func TestGroupDoShares(t *testing.T) {
+	g := NewGroup[string, int]()
+	var calls atomic.Int32
+	release := make(chan struct{})
+	fn := func(context.Context) (int, error) {
+		calls.Add(1)
+		<-release
+		return 42, nil
+	}
+
+	const callers = 10
+	var wg sync.WaitGroup
+	var shared atomic.Int32
+	for range callers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			v, err, s := g.Do(context.Background(), "k", fn)
+			if v != 42 || err != nil {
+				t.Errorf("Do = %d, %v", v, err)
+			}
+			if s {
+				shared.Add(1)
+			}
+		}()
+	}
+	waitWaiters(g, "k", callers)
+	close(release)
+	wg.Wait()
+
+	if n := calls.Load(); n != 1 {
+		t.Fatalf("fn ran %d times", n)
+	}
+	if n := shared.Load(); n != callers {
+		t.Fatalf("%d callers got shared results", n)
+	}
+}
+
+func TestGroupDoCancel(t *testing.T) {
+	g := NewGroup[string, int]()
+	started := make(chan struct{})
+	fnCtxDone := make(chan struct{})
+	var fnCtx context.Context
+	fn := func(ctx context.Context) (int, error) {
+		fnCtx = ctx
+		close(started)
+		<-ctx.Done()
+		close(fnCtxDone)
+		return 0, ctx.Err()
+	}
+
+	ctx1, cancel1 := context.WithCancel(context.Background())
+	ctx2, cancel2 := context.WithCancel(context.Background())
+	errs := make(chan error, 2)
+	go func() {
+		_, err, _ := g.Do(ctx1, "k", fn)
+		errs <- err
+	}()
+	<-started
+	go func() {
+		_, err, _ := g.Do(ctx2, "k", fn)
+		errs <- err
+	}()
+	waitWaiters(g, "k", 2)
+
+	// One caller leaving must not cancel fn for the other. A caller that cancels
+	// fn does so before its Do returns, so checking fnCtx right away is enough.
+	cancel1()
+	if err := <-errs; !errors.Is(err, context.Canceled) {
+		t.Fatalf("first caller: %v", err)
+	}
+	if fnCtx.Err() != nil {
+		t.Fatal("fn canceled while a caller is still waiting")
+	}
+
+	cancel2()
+	if err := <-errs; !errors.Is(err, context.Canceled) {
+		t.Fatalf("second caller: %v", err)
+	}
+	select {
+	case <-fnCtxDone:
+	case <-time.After(time.Second):
+		t.Fatal("fn not canceled after all callers left")
+	}
+}
+
+func TestGroupPanicAndForget(t *testing.T) {
+	g := NewGroup[int, int]()
+	r := <-g.DoChan(context.Background(), 1, func(context.Context) (int, error) {
+		panic("boom")
+	})
+	var pe *PanicError
+	if !errors.As(r.Err, &pe) {
+		t.Fatalf("err = %v", r.Err)
+	}
+
+	release := make(chan struct{})
+	go g.Do(context.Background(), 2, func(context.Context) (int, error) {
+		<-release
+		return 1, nil
+	})
+	waitWaiters(g, 2, 1)
+	g.Forget(2)
+	v, err, shared := g.Do(context.Background(), 2, func(context.Context) (int, error) {
+		return 2, nil
+	})
+	close(release)
+	if v != 2 || err != nil || shared {
+		t.Fatalf("Do after Forget = %d, %v, %v", v, err, shared)
+	}
+}
@@ -0,0 +1,87 @@
+package once
+
+import (
+	"fmt"
+	"runtime/debug"
+	"sync"
+)
+
+type Handle interface {
+	// Wait blocks until the function started by GoHandle returns.
+	Wait()
+
+	// Done returns a chan that is closed when the function returns.
+	Done() <-chan struct{}
+
+	// Err returns the error of the function after it returned, or <nil> while it is running.
+	// If the function panicked, Err returns a [*PanicError].
+	Err() error
+}
+
+type PanicError struct {
+	Value any
+	Stack []byte
+}
+
+func (e *PanicError) Error() string {
+	return fmt.Sprintf("once: panic: %v\n\n%s", e.Value, e.Stack)
+}
+
+func (e *PanicError) Unwrap() error {
+	if err, ok := e.Value.(error); ok {
+		return err
+	}
+	return nil
+}
+
+var _ Handle = (*handleImpl)(nil)
+
+type handleImpl struct {
+	done chan struct{}
+	mu   sync.Mutex
+	err  error
+
+	// rearmed is set before done is closed if the once was armed again
+	// instead of being fired, so waiters should retry.
+	rearmed bool
+}
+
+func (h *handleImpl) run(f func() error) {
+	h.finish(call(f))
+}
+
+func (h *handleImpl) finish(err error) {
+	h.mu.Lock()
+	h.err = err
+	h.mu.Unlock()
+	close(h.done)
+}
+
+func (h *handleImpl) Wait()                 { <-h.done }
+func (h *handleImpl) Done() <-chan struct{} { return h.done }
+
+func (h *handleImpl) Err() error {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	return h.err
+}
+
+func newHandle() *handleImpl {
+	return &handleImpl{done: make(chan struct{})}
+}
+
+// call runs f and turns a recovered panic into a [*PanicError].
+func call(f func() error) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = &PanicError{Value: r, Stack: debug.Stack()}
+		}
+	}()
+	return f()
+}
+
+var closedHandle = func() *handleImpl {
+	h := newHandle()
+	close(h.done)
+	return h
+}()
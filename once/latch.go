@@ -0,0 +1,116 @@
+package once
+
+import (
+	"context"
+	"sync"
+)
+
+type CountDownLatch interface {
+	// CountDown decrements the count. When the count reaches zero, every waiter is woken.
+	// Calls after that do nothing.
+	CountDown()
+
+	Count() int
+
+	// Wait blocks until the count reaches zero or ctx is done.
+	Wait(ctx context.Context) error
+
+	// Done returns a chan that is closed when the count reaches zero.
+	Done() <-chan struct{}
+}
+
+var _ CountDownLatch = (*countDownLatchImpl)(nil)
+
+type countDownLatchImpl struct {
+	mu    sync.Mutex
+	count int
+	gate  Gate
+}
+
+func (l *countDownLatchImpl) CountDown() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.count == 0 {
+		return
+	}
+	l.count--
+	if l.count == 0 {
+		l.gate.Open()
+	}
+}
+
+func (l *countDownLatchImpl) Count() int {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.count
+}
+
+func (l *countDownLatchImpl) Wait(ctx context.Context) error { return l.gate.Wait(ctx) }
+func (l *countDownLatchImpl) Done() <-chan struct{}          { return l.gate.Done() }
+
+func NewCountDownLatch(n int) CountDownLatch {
+	l := &countDownLatchImpl{count: max(n, 0), gate: NewGate()}
+	if l.count == 0 {
+		l.gate.Open()
+	}
+	return l
+}
+
+type Barrier interface {
+	// Wait blocks until all parties have called Wait, then wakes them all and
+	// resets the barrier for the next round.
+	//
+	// If ctx is done before the barrier trips, the caller leaves the round and
+	// Wait returns the ctx error. The other parties keep waiting.
+	Wait(ctx context.Context) error
+
+	// Waiting returns the number of parties waiting in the current round.
+	Waiting() int
+}
+
+var _ Barrier = (*barrierImpl)(nil)
+
+type barrierImpl struct {
+	mu      sync.Mutex
+	parties int
+	count   int
+	round   chan struct{}
+}
+
+func (b *barrierImpl) Wait(ctx context.Context) error {
+	b.mu.Lock()
+	b.count++
+	if b.count >= b.parties {
+		close(b.round)
+		b.round = make(chan struct{})
+		b.count = 0
+		b.mu.Unlock()
+		return nil
+	}
+	round := b.round
+	b.mu.Unlock()
+
+	select {
+	case <-round:
+		return nil
+	case <-ctx.Done():
+		b.mu.Lock()
+		defer b.mu.Unlock()
+		if round != b.round {
+			// The barrier tripped concurrently with ctx.
+			return nil
+		}
+		b.count--
+		return ctx.Err()
+	}
+}
+
+func (b *barrierImpl) Waiting() int {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.count
+}
+
+func NewBarrier(parties int) Barrier {
+	return &barrierImpl{parties: parties, round: make(chan struct{})}
+}
@@ -0,0 +1,95 @@
+package once
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestBarrierRounds(t *testing.T) {
+	const (
+		parties = 4
+		rounds  = 50
+	)
+	b := NewBarrier(parties)
+	var mu sync.Mutex
+	passed := make([]int, rounds)
+	var wg sync.WaitGroup
+	for range parties {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for r := range rounds {
+				if err := b.Wait(context.Background()); err != nil {
+					t.Error(err)
+					return
+				}
+				mu.Lock()
+				passed[r]++
+				// No party may reach a round before all of them passed the previous one.
+				if r > 0 && passed[r-1] != parties {
+					t.Errorf("round %d passed before round %d finished", r, r-1)
+				}
+				mu.Unlock()
+			}
+		}()
+	}
+	wg.Wait()
+}
+
+func TestBarrierWaiterLeaves(t *testing.T) {
+	b := NewBarrier(2)
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if err := b.Wait(ctx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("Wait = %v", err)
+	}
+	if n := b.Waiting(); n != 0 {
+		t.Fatalf("%d waiting after leaving", n)
+	}
+
+	// The barrier still needs two parties.
+	done := make(chan error, 1)
+	go func() { done <- b.Wait(context.Background()) }()
+	select {
+	case <-done:
+		t.Fatal("barrier tripped with one party")
+	case <-time.After(10 * time.Millisecond):
+	}
+	if err := b.Wait(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	if err := <-done; err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestCountDownLatchAndGate(t *testing.T) {
+	l := NewCountDownLatch(2)
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if err := l.Wait(ctx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("Wait before zero = %v", err)
+	}
+	l.CountDown()
+	l.CountDown()
+	l.CountDown()
+	if l.Count() != 0 {
+		t.Fatalf("Count = %d", l.Count())
+	}
+	if err := l.Wait(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+
+	g := NewGate()
+	if g.IsOpen() {
+		t.Fatal("new gate is open")
+	}
+	g.Open()
+	g.Open()
+	if err := g.Wait(context.Background()); err != nil || !g.IsOpen() {
+		t.Fatalf("Wait = %v, open %v", err, g.IsOpen())
+	}
+}
@@ -0,0 +1,142 @@
+package once
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+type Lazy[T any] interface {
+	// Get returns the cached value, computing it on the first call.
+	// Concurrent first calls wait for a single computation, or until their ctx is done.
+	//
+	// After the TTL has passed, Get keeps returning the stale value and refreshes it
+	// in the background. If the refresh fails, the last good value is kept and the
+	// next Get tries again. The background refresh does not stop when the ctx of the
+	// Get that started it is done, but it gets a deadline of one TTL (none if the TTL
+	// is not positive), so a hanging f does not block refreshes forever.
+	Get(ctx context.Context) (T, error)
+
+	// Err returns the error of the last failed computation, or <nil> if the last one succeeded.
+	Err() error
+
+	// Invalidate marks the value as stale, so the next Get refreshes it in the background.
+	Invalidate()
+
+	// Reset drops the value, so the next Get computes it again and waits for the result.
+	Reset()
+}
+
+var _ Lazy[any] = (*lazyImpl[any])(nil)
+
+type lazyImpl[T any] struct {
+	mu      sync.Mutex
+	f       func(context.Context) (T, error)
+	ttl     time.Duration
+	clock   Clock
+	value   T
+	has     bool
+	stale   bool
+	fetched time.Time
+	err     error
+
+	// canceled is set if the last computation failed because its context was done,
+	// so waiters should start a new one instead of returning err.
+	canceled bool
+
+	// loading is non-nil while a computation is running and is closed when it finishes.
+	loading chan struct{}
+}
+
+func (l *lazyImpl[T]) load(ctx context.Context) (v T, err error) {
+	err = call(func() (err error) {
+		v, err = l.f(ctx)
+		return err
+	})
+	return v, err
+}
+
+// finish stores the result of a computation. l.mu must be held.
+func (l *lazyImpl[T]) finish(v T, err error, canceled bool) {
+	close(l.loading)
+	l.loading = nil
+	l.err = err
+	l.canceled = canceled
+	if err != nil {
+		return
+	}
+	l.value = v
+	l.has = true
+	l.stale = false
+	l.fetched = l.clock.Now()
+}
+
+func (l *lazyImpl[T]) Get(ctx context.Context) (v T, err error) {
+	l.mu.Lock()
+	for !l.has {
+		if l.loading == nil {
+			l.loading = make(chan struct{})
+			l.mu.Unlock()
+			v, err := l.load(ctx)
+			l.mu.Lock()
+			defer l.mu.Unlock()
+			l.finish(v, err, isContextErr(ctx, err))
+			return v, err
+		}
+		loading := l.loading
+		l.mu.Unlock()
+		if err := waitChan(ctx, loading); err != nil {
+			return v, err
+		}
+		l.mu.Lock()
+		if !l.has && l.err != nil && !l.canceled {
+			err := l.err
+			l.mu.Unlock()
+			return v, err
+		}
+	}
+	defer l.mu.Unlock()
+
+	if l.loading == nil && (l.stale || l.clock.Now().Sub(l.fetched) >= l.ttl) {
+		l.loading = make(chan struct{})
+		go func() {
+			ctx := context.WithoutCancel(ctx)
+			if l.ttl > 0 {
+				var cancel context.CancelFunc
+				ctx, cancel = context.WithTimeout(ctx, l.ttl)
+				defer cancel()
+			}
+			v, err := l.load(ctx)
+			l.mu.Lock()
+			defer l.mu.Unlock()
+			l.finish(v, err, false)
+		}()
+	}
+	return l.value, nil
+}
+
+func (l *lazyImpl[T]) Err() error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.err
+}
+
+func (l *lazyImpl[T]) Invalidate() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.stale = true
+}
+
+func (l *lazyImpl[T]) Reset() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	var zero T
+	l.value = zero
+	l.has = false
+}
+
+// NewLazy returns a [Lazy] that computes its value with f and caches it for ttl.
+func NewLazy[T any](f func(context.Context) (T, error), ttl time.Duration, opts ...TimerOpt) Lazy[T] {
+	t := newTimerOpts(opts)
+	return &lazyImpl[T]{f: f, ttl: ttl, clock: t.clock}
+}
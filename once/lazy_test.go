@@ -0,0 +1,214 @@
+package once
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestLazyWaiterRetriesAfterCanceledLoad(t *testing.T) {
+	started := make(chan struct{})
+	calls := 0
+	l := NewLazy(func(ctx context.Context) (int, error) {
+		calls++
+		if calls == 1 {
+			close(started)
+			<-ctx.Done()
+			return 0, ctx.Err()
+		}
+		return 42, nil
+	}, time.Minute)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	firstErr := make(chan error, 1)
+	go func() {
+		_, err := l.Get(ctx)
+		firstErr <- err
+	}()
+	<-started
+
+	waiter := make(chan error, 1)
+	var got int
+	go func() {
+		var err error
+		got, err = l.Get(context.Background())
+		waiter <- err
+	}()
+	// Give the waiter time to start waiting for the first load.
+	time.Sleep(10 * time.Millisecond)
+	cancel()
+
+	if err := <-firstErr; !errors.Is(err, context.Canceled) {
+		t.Fatalf("first Get: %v", err)
+	}
+	if err := <-waiter; err != nil {
+		t.Fatalf("waiter Get: %v", err)
+	}
+	if got != 42 {
+		t.Fatalf("waiter got %d", got)
+	}
+}
+
+func TestLazyWaiterGetsLoadError(t *testing.T) {
+	errLoad := errors.New("load failed")
+	release := make(chan struct{})
+	l := NewLazy(func(context.Context) (int, error) {
+		<-release
+		return 0, errLoad
+	}, time.Minute)
+
+	errs := make(chan error, 2)
+	for range 2 {
+		go func() {
+			_, err := l.Get(context.Background())
+			errs <- err
+		}()
+	}
+	time.Sleep(10 * time.Millisecond)
+	close(release)
+	for range 2 {
+		if err := <-errs; !errors.Is(err, errLoad) {
+			t.Fatalf("Get: %v", err)
+		}
+	}
+}
+
+// waitRefresh waits for the computation started by the last Get, if any.
+func waitRefresh[T any](l Lazy[T]) {
+	li := l.(*lazyImpl[T])
+	li.mu.Lock()
+	loading := li.loading
+	li.mu.Unlock()
+	if loading != nil {
+		<-loading
+	}
+}
+
+func TestLazyTTL(t *testing.T) {
+	clock := newFakeClock()
+	calls := 0
+	l := NewLazy(func(context.Context) (int, error) {
+		calls++
+		return calls, nil
+	}, time.Minute, WithClock(clock))
+
+	ctx := context.Background()
+	if v, err := l.Get(ctx); v != 1 || err != nil {
+		t.Fatalf("first Get = %d, %v", v, err)
+	}
+	clock.Advance(time.Minute - 1)
+	if v, _ := l.Get(ctx); v != 1 || calls != 1 {
+		t.Fatalf("Get before the TTL = %d, %d calls", v, calls)
+	}
+	clock.Advance(1)
+	// The expired value is served while it is refreshed in the background.
+	if v, _ := l.Get(ctx); v != 1 {
+		t.Fatalf("Get at the TTL = %d, want the stale value", v)
+	}
+	waitRefresh(l)
+	if v, _ := l.Get(ctx); v != 2 || calls != 2 {
+		t.Fatalf("Get after the refresh = %d, %d calls", v, calls)
+	}
+}
+
+func TestLazyServesStaleWhileRefreshing(t *testing.T) {
+	clock := newFakeClock()
+	release := make(chan struct{})
+	var deadline time.Time
+	calls := 0
+	l := NewLazy(func(ctx context.Context) (string, error) {
+		calls++
+		if calls == 1 {
+			return "old", nil
+		}
+		deadline, _ = ctx.Deadline()
+		<-release
+		return "new", nil
+	}, time.Minute, WithClock(clock))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	l.Get(ctx)
+	clock.Advance(time.Minute)
+	for range 3 {
+		if v, err := l.Get(ctx); v != "old" || err != nil {
+			t.Fatalf("Get during the refresh = %q, %v", v, err)
+		}
+	}
+	// Canceling the ctx of the Get that started the refresh does not stop it.
+	cancel()
+	close(release)
+	waitRefresh(l)
+	if calls != 2 {
+		t.Fatalf("f ran %d times, want one refresh", calls)
+	}
+	if v, _ := l.Get(context.Background()); v != "new" {
+		t.Fatalf("Get after the refresh = %q", v)
+	}
+	if deadline.IsZero() || time.Until(deadline) > time.Minute {
+		t.Fatalf("refresh deadline = %v, want one TTL", deadline)
+	}
+}
+
+func TestLazyKeepsValueAfterFailedRefresh(t *testing.T) {
+	clock := newFakeClock()
+	errRefresh := errors.New("refresh failed")
+	fail := false
+	l := NewLazy(func(context.Context) (int, error) {
+		if fail {
+			return 0, errRefresh
+		}
+		return 1, nil
+	}, time.Minute, WithClock(clock))
+
+	ctx := context.Background()
+	l.Get(ctx)
+	fail = true
+	clock.Advance(time.Minute)
+	l.Get(ctx)
+	waitRefresh(l)
+	if v, err := l.Get(ctx); v != 1 || err != nil {
+		t.Fatalf("Get after a failed refresh = %d, %v", v, err)
+	}
+	if err := l.Err(); !errors.Is(err, errRefresh) {
+		t.Fatalf("Err = %v", err)
+	}
+
+	// The Get above started another refresh, which succeeds and clears Err.
+	waitRefresh(l)
+	fail = false
+	l.Get(ctx)
+	waitRefresh(l)
+	if err := l.Err(); err != nil {
+		t.Fatalf("Err after a good refresh = %v", err)
+	}
+}
+
+func TestLazyInvalidateAndReset(t *testing.T) {
+	clock := newFakeClock()
+	calls := 0
+	l := NewLazy(func(context.Context) (int, error) {
+		calls++
+		return calls, nil
+	}, time.Hour, WithClock(clock))
+
+	ctx := context.Background()
+	l.Get(ctx)
+	l.Invalidate()
+	if v, _ := l.Get(ctx); v != 1 {
+		t.Fatalf("Get after Invalidate = %d, want the stale value", v)
+	}
+	waitRefresh(l)
+	if v, _ := l.Get(ctx); v != 2 {
+		t.Fatalf("Get after the refresh = %d", v)
+	}
+
+	l.Reset()
+	if v, _ := l.Get(ctx); v != 3 {
+		t.Fatalf("Get after Reset = %d, want a fresh value", v)
+	}
+	waitRefresh(l)
+	if calls != 3 {
+		t.Fatalf("f ran %d times", calls)
+	}
+}
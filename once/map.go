@@ -1,6 +1,9 @@
 package once
 
-import "sync"
+import (
+	"context"
+	"sync"
+)
 
 type Map interface {
 	Get(string) Once
@@ -10,42 +13,74 @@ type Map interface {
 	GoOnce(string, func())
 }
 
-var _ Map = (*onceMapImpl)(nil)
+// HandleMap is a [Map] of onces that can report the result of a background run.
+// The Map returned by [NewMap] implements it; [NewHandleMap] returns it without
+// a type assertion.
+type HandleMap interface {
+	Map
+	GoHandleOnce(string, func() error) Handle
+}
+
+// ContextMap is a [Map] of onces that can run a function with a context.
+// The Map returned by [NewMap] implements it; [NewContextMap] returns it without
+// a type assertion.
+type ContextMap interface {
+	Map
+	RunContextOnce(context.Context, string, func(context.Context) error) error
+}
+
+var (
+	_ Map        = (*onceMapImpl)(nil)
+	_ HandleMap  = (*onceMapImpl)(nil)
+	_ ContextMap = (*onceMapImpl)(nil)
+)
 
 type onceMapImpl struct {
-	mu    sync.RWMutex
-	onces map[string]Once
-}
-
-func (o *onceMapImpl) Get(name string) Once {
-	o.mu.RLock()
-	defer o.mu.RUnlock()
-	once, ok := o.onces[name]
-	if !ok {
-		o.mu.RUnlock()
-		o.mu.Lock()
-		once = New()
-		o.onces[name] = once
-		o.mu.Unlock()
-		o.mu.RLock()
+	onces sync.Map // map[string]*onceImpl
+}
+
+func (o *onceMapImpl) get(name string) *onceImpl {
+	// Load first so the hot path neither allocates nor writes.
+	if once, ok := o.onces.Load(name); ok {
+		return once.(*onceImpl)
 	}
-	return once
+	once, _ := o.onces.LoadOrStore(name, newOnce())
+	return once.(*onceImpl)
 }
 
+func (o *onceMapImpl) Get(name string) Once { return o.get(name) }
+
 func (o *onceMapImpl) BoolOnce(name string) bool     { return o.Get(name).Bool() }
 func (o *onceMapImpl) ResetOnce(name string)         { o.Get(name).Reset() }
 func (o *onceMapImpl) RunOnce(name string, f func()) { o.Get(name).Run(f) }
 func (o *onceMapImpl) GoOnce(name string, f func())  { o.Get(name).Go(f) }
 
-var defaultOnceMap Map
+func (o *onceMapImpl) GoHandleOnce(name string, f func() error) Handle {
+	return o.get(name).GoHandle(f)
+}
+
+func (o *onceMapImpl) RunContextOnce(ctx context.Context, name string, f func(context.Context) error) error {
+	return o.get(name).RunContext(ctx, f)
+}
+
+var defaultOnceMap = sync.OnceValue(NewMap)
 
 func NewMap() Map {
-	return &onceMapImpl{onces: make(map[string]Once)}
+	return newMap()
+}
+
+func NewHandleMap() HandleMap {
+	return newMap()
+}
+
+func NewContextMap() ContextMap {
+	return newMap()
+}
+
+func newMap() *onceMapImpl {
+	return &onceMapImpl{}
 }
 
 func DefaultMap() Map {
-	if defaultOnceMap == nil {
-		defaultOnceMap = NewMap()
-	}
-	return defaultOnceMap
+	return defaultOnceMap()
 }
@@ -0,0 +1,100 @@
+package once
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"testing"
+)
+
+func TestMapRunOnceConcurrent(t *testing.T) {
+	const (
+		names      = 16
+		goroutines = 64
+	)
+	m := NewMap()
+	var runs [names]atomic.Int32
+	var wg sync.WaitGroup
+	for range goroutines {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range names {
+				m.RunOnce(fmt.Sprint(i), func() { runs[i].Add(1) })
+			}
+		}()
+	}
+	wg.Wait()
+	for i := range names {
+		if n := runs[i].Load(); n != 1 {
+			t.Errorf("%d: ran %d times", i, n)
+		}
+	}
+}
+
+func TestHandleAndContextMap(t *testing.T) {
+	errRun := errors.New("run failed")
+	hm := NewHandleMap()
+	h := hm.GoHandleOnce("a", func() error { return errRun })
+	h.Wait()
+	if err := h.Err(); !errors.Is(err, errRun) {
+		t.Fatalf("GoHandleOnce = %v", err)
+	}
+	if h2 := hm.GoHandleOnce("a", func() error { return nil }); h2 != h {
+		t.Fatal("second GoHandleOnce returned a different handle")
+	}
+
+	cm := NewContextMap()
+	runs := 0
+	for range 2 {
+		if err := cm.RunContextOnce(context.Background(), "a", func(context.Context) error {
+			runs++
+			return nil
+		}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if runs != 1 {
+		t.Fatalf("RunContextOnce ran %d times", runs)
+	}
+}
+
+func TestDefaultMapConcurrent(t *testing.T) {
+	maps := make([]Map, 32)
+	var wg sync.WaitGroup
+	for i := range maps {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			maps[i] = DefaultMap()
+		}()
+	}
+	wg.Wait()
+	for _, m := range maps {
+		if m != maps[0] {
+			t.Fatal("DefaultMap returned different maps")
+		}
+	}
+}
+
+// BenchmarkMapGet measures the read path on existing names from many goroutines.
+// It should scale with GOMAXPROCS, since Get does not write for existing names.
+func BenchmarkMapGet(b *testing.B) {
+	m := NewMap()
+	names := make([]string, 64)
+	for i := range names {
+		names[i] = fmt.Sprint(i)
+		m.Get(names[i])
+	}
+	b.ReportAllocs()
+	b.ResetTimer()
+	b.RunParallel(func(pb *testing.PB) {
+		i := 0
+		for pb.Next() {
+			m.Get(names[i%len(names)])
+			i++
+		}
+	})
+}
@@ -1,6 +1,10 @@
 package once
 
-import "sync"
+import (
+	"context"
+	"errors"
+	"sync"
+)
 
 type Once interface {
 	Bool() bool
@@ -9,11 +13,47 @@ type Once interface {
 	Go(func())
 }
 
-var _ Once = (*onceImpl)(nil)
+// HandleOnce is a [Once] that can report the result of a background run.
+// The Once returned by [New] implements it; [NewHandleOnce] returns it without
+// a type assertion.
+type HandleOnce interface {
+	Once
+
+	// GoHandle runs f in a new goroutine if the once is armed and returns a [Handle] for it.
+	// Callers that lose the race get the handle of the winner. If the once was fired
+	// without GoHandle, an already completed handle is returned.
+	GoHandle(f func() error) Handle
+}
+
+// ContextOnce is a [Once] that can run a function with a context.
+// The Once returned by [New] implements it; [NewContextOnce] returns it without
+// a type assertion.
+type ContextOnce interface {
+	Once
+
+	// RunContext runs f with ctx if the once is armed and returns its error.
+	// If f fails because its context was canceled or timed out, the once is armed again.
+	//
+	// Callers that lose the race wait for the winner and return its error. A waiter can
+	// abandon the wait by canceling its own ctx, which does not affect the winner.
+	// If the winner re-arms the once, one of the waiters runs f instead.
+	//
+	// If the once was fired by Bool, Run or Go, RunContext returns <nil> at once
+	// without waiting, even if the f passed to Run or Go is still running, since
+	// the once does not track those calls.
+	RunContext(ctx context.Context, f func(context.Context) error) error
+}
+
+var (
+	_ Once        = (*onceImpl)(nil)
+	_ HandleOnce  = (*onceImpl)(nil)
+	_ ContextOnce = (*onceImpl)(nil)
+)
 
 type onceImpl struct {
-	mu   sync.Mutex
-	cond bool
+	mu     sync.Mutex
+	cond   bool
+	handle *handleImpl
 }
 
 func (o *onceImpl) Bool() bool {
@@ -21,6 +61,7 @@ func (o *onceImpl) Bool() bool {
 	defer o.mu.Unlock()
 	if o.cond {
 		o.cond = false
+		o.handle = nil
 		return true
 	}
 	return false
@@ -44,6 +85,83 @@ func (o *onceImpl) Go(f func()) {
 	}
 }
 
+func (o *onceImpl) GoHandle(f func() error) Handle {
+	o.mu.Lock()
+	if !o.cond {
+		h := o.handle
+		o.mu.Unlock()
+		if h == nil {
+			return closedHandle
+		}
+		return h
+	}
+	o.cond = false
+	h := newHandle()
+	o.handle = h
+	o.mu.Unlock()
+
+	go h.run(f)
+	return h
+}
+
+func (o *onceImpl) RunContext(ctx context.Context, f func(context.Context) error) error {
+	for {
+		o.mu.Lock()
+		if o.cond {
+			o.cond = false
+			h := newHandle()
+			o.handle = h
+			o.mu.Unlock()
+
+			err := call(func() error { return f(ctx) })
+			if isContextErr(ctx, err) {
+				o.mu.Lock()
+				o.cond = true
+				o.handle = nil
+				h.rearmed = true
+				o.mu.Unlock()
+			}
+			h.finish(err)
+			return err
+		}
+		h := o.handle
+		o.mu.Unlock()
+
+		if h == nil {
+			return nil
+		}
+		select {
+		case <-h.done:
+			if !h.rearmed {
+				return h.Err()
+			}
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+func isContextErr(ctx context.Context, err error) bool {
+	if err == nil {
+		return false
+	}
+	return ctx.Err() != nil ||
+		errors.Is(err, context.Canceled) ||
+		errors.Is(err, context.DeadlineExceeded)
+}
+
 func New() Once {
+	return newOnce()
+}
+
+func NewHandleOnce() HandleOnce {
+	return newOnce()
+}
+
+func NewContextOnce() ContextOnce {
+	return newOnce()
+}
+
+func newOnce() *onceImpl {
 	return &onceImpl{cond: true}
 }
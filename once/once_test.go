@@ -0,0 +1,139 @@
+package once
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestRunContextRearmsOnCancel(t *testing.T) {
+	o := NewContextOnce()
+	ctx, cancel := context.WithCancel(context.Background())
+	started := make(chan struct{})
+	winner := make(chan error, 1)
+	go func() {
+		winner <- o.RunContext(ctx, func(ctx context.Context) error {
+			close(started)
+			<-ctx.Done()
+			return ctx.Err()
+		})
+	}()
+	<-started
+
+	var runs atomic.Int32
+	waiter := make(chan error, 1)
+	go func() {
+		waiter <- o.RunContext(context.Background(), func(context.Context) error {
+			runs.Add(1)
+			return nil
+		})
+	}()
+	time.Sleep(10 * time.Millisecond)
+	cancel()
+
+	if err := <-winner; !errors.Is(err, context.Canceled) {
+		t.Fatalf("winner: %v", err)
+	}
+	if err := <-waiter; err != nil {
+		t.Fatalf("waiter: %v", err)
+	}
+	if n := runs.Load(); n != 1 {
+		t.Fatalf("waiter ran f %d times", n)
+	}
+	if o.Bool() {
+		t.Fatal("once is armed after a successful run")
+	}
+}
+
+func TestRunContextWaiterAbandons(t *testing.T) {
+	o := NewContextOnce()
+	started := make(chan struct{})
+	release := make(chan struct{})
+	winner := make(chan error, 1)
+	go func() {
+		winner <- o.RunContext(context.Background(), func(context.Context) error {
+			close(started)
+			<-release
+			return nil
+		})
+	}()
+	<-started
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	err := o.RunContext(ctx, func(context.Context) error {
+		t.Error("waiter ran f")
+		return nil
+	})
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("waiter: %v", err)
+	}
+
+	close(release)
+	if err := <-winner; err != nil {
+		t.Fatalf("winner: %v", err)
+	}
+	if err := o.RunContext(context.Background(), func(context.Context) error {
+		t.Error("f ran again")
+		return nil
+	}); err != nil {
+		t.Fatalf("after winner: %v", err)
+	}
+}
+
+func TestRunContextPanic(t *testing.T) {
+	o := NewContextOnce()
+	err := o.RunContext(context.Background(), func(context.Context) error {
+		panic("boom")
+	})
+	var pe *PanicError
+	if !errors.As(err, &pe) || pe.Value != "boom" {
+		t.Fatalf("err = %v", err)
+	}
+	// The panic is a failure of f, not a cancellation, so the once stays fired.
+	if err2 := o.RunContext(context.Background(), func(context.Context) error { return nil }); err2 != err {
+		t.Fatalf("second call: %v", err2)
+	}
+}
+
+func TestGoHandle(t *testing.T) {
+	o := NewHandleOnce()
+	release := make(chan struct{})
+	h1 := o.GoHandle(func() error {
+		<-release
+		panic(errors.New("boom"))
+	})
+	h2 := o.GoHandle(func() error { return nil })
+	if h1 != h2 {
+		t.Fatal("losing caller got a different handle")
+	}
+	select {
+	case <-h1.Done():
+		t.Fatal("done before f returned")
+	default:
+	}
+	close(release)
+	h1.Wait()
+	var pe *PanicError
+	if !errors.As(h1.Err(), &pe) || errors.Unwrap(pe) == nil {
+		t.Fatalf("err = %v", h1.Err())
+	}
+}
+
+func TestRunContextAfterRun(t *testing.T) {
+	o := NewContextOnce()
+	release := make(chan struct{})
+	o.Go(func() { <-release })
+	defer close(release)
+
+	// RunContext does not wait for f passed to Go, and does not run its own f.
+	ran := false
+	if err := o.RunContext(context.Background(), func(context.Context) error {
+		ran = true
+		return nil
+	}); err != nil || ran {
+		t.Fatalf("RunContext = %v, ran = %v", err, ran)
+	}
+}
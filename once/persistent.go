@@ -0,0 +1,170 @@
+package once
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+)
+
+// PersistentMap is a [Map]-like set of onces whose fired state is stored in a state file,
+// so it survives restarts. It is safe for concurrent use within one process,
+// but not across processes.
+//
+// Each name is fired for a version. Firing a name for a different version than
+// the stored one runs it again, which allows re-running a changed migration.
+type PersistentMap interface {
+	// BoolOnce returns true and stores the name as fired if it was not fired for the version.
+	BoolOnce(name string, version int) (bool, error)
+
+	// RunOnce runs f if the name was not fired for the version and stores it as fired
+	// if f succeeds.
+	RunOnce(name string, version int, f func() error) error
+
+	// Fired reports whether the name was fired for the version.
+	Fired(name string, version int) bool
+
+	// List returns the fired markers sorted by name.
+	List() []Marker
+
+	// ResetOnce removes the marker for the name, so it will be fired again.
+	ResetOnce(name string) error
+}
+
+type Marker struct {
+	Name    string    `json:"-"`
+	Version int       `json:"version"`
+	FiredAt time.Time `json:"fired_at"`
+}
+
+const persistentStateVersion = 1
+
+type persistentState struct {
+	Version int               `json:"version"`
+	Markers map[string]Marker `json:"markers"`
+}
+
+var _ PersistentMap = (*persistentMapImpl)(nil)
+
+type persistentMapImpl struct {
+	path    string
+	mu      sync.RWMutex
+	markers map[string]Marker
+	running sync.Map // map[string]*sync.Mutex
+}
+
+func (p *persistentMapImpl) Fired(name string, version int) bool {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	m, ok := p.markers[name]
+	return ok && m.Version == version
+}
+
+func (p *persistentMapImpl) fire(name string, version int) (bool, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if m, ok := p.markers[name]; ok && m.Version == version {
+		return false, nil
+	}
+	prev, had := p.markers[name]
+	p.markers[name] = Marker{Name: name, Version: version, FiredAt: time.Now()}
+	if err := p.save(); err != nil {
+		if had {
+			p.markers[name] = prev
+		} else {
+			delete(p.markers, name)
+		}
+		return false, err
+	}
+	return true, nil
+}
+
+func (p *persistentMapImpl) BoolOnce(name string, version int) (bool, error) {
+	return p.fire(name, version)
+}
+
+func (p *persistentMapImpl) RunOnce(name string, version int, f func() error) error {
+	mu, _ := p.running.LoadOrStore(name, &sync.Mutex{})
+	mu.(*sync.Mutex).Lock()
+	defer mu.(*sync.Mutex).Unlock()
+
+	if p.Fired(name, version) {
+		return nil
+	}
+	if err := call(f); err != nil {
+		return err
+	}
+	_, err := p.fire(name, version)
+	return err
+}
+
+func (p *persistentMapImpl) List() []Marker {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	list := make([]Marker, 0, len(p.markers))
+	for _, m := range p.markers {
+		list = append(list, m)
+	}
+	slices.SortFunc(list, func(a, b Marker) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+	return list
+}
+
+func (p *persistentMapImpl) ResetOnce(name string) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	prev, ok := p.markers[name]
+	if !ok {
+		return nil
+	}
+	delete(p.markers, name)
+	if err := p.save(); err != nil {
+		p.markers[name] = prev
+		return err
+	}
+	return nil
+}
+
+// save writes the state file. p.mu must be held.
+func (p *persistentMapImpl) save() error {
+	data, err := json.MarshalIndent(persistentState{
+		Version: persistentStateVersion,
+		Markers: p.markers,
+	}, "", "\t")
+	if err != nil {
+		return fmt.Errorf("encode state: %w", err)
+	}
+	return writeFileAtomic(p.path, data)
+}
+
+// OpenPersistentMap loads the state file at path, or starts with an empty state
+// if it does not exist. The file is created on the first change.
+func OpenPersistentMap(path string) (PersistentMap, error) {
+	p := &persistentMapImpl{path: path, markers: make(map[string]Marker)}
+	data, err := os.ReadFile(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return p, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("read state: %w", err)
+	}
+
+	var state persistentState
+	if err := json.Unmarshal(data, &state); err != nil {
+		return nil, fmt.Errorf("decode state %s: %w", path, err)
+	}
+	if state.Version != persistentStateVersion {
+		return nil, fmt.Errorf("unsupported state version %d in %s", state.Version, path)
+	}
+	for name, m := range state.Markers {
+		m.Name = name
+		p.markers[name] = m
+	}
+	return p, nil
+}
@@ -0,0 +1,38 @@
+package once
+
+import "testing"
+
+func TestPersistentMapReopen(t *testing.T) {
+	path := t.TempDir() + "/state.json"
+	m, err := OpenPersistentMap(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	runs := 0
+	run := func() error {
+		runs++
+		return nil
+	}
+	if err := m.RunOnce("migrate", 1, run); err != nil {
+		t.Fatal(err)
+	}
+
+	m, err = OpenPersistentMap(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	m.RunOnce("migrate", 1, run)
+	if runs != 1 {
+		t.Fatalf("ran %d times after reopen", runs)
+	}
+	m.RunOnce("migrate", 2, run)
+	if runs != 2 {
+		t.Fatalf("ran %d times after version change", runs)
+	}
+	if list := m.List(); len(list) != 1 || list[0].Name != "migrate" || list[0].Version != 2 {
+		t.Fatalf("List = %v", list)
+	}
+	if err := m.ResetOnce("migrate"); err != nil || m.Fired("migrate", 2) {
+		t.Fatalf("ResetOnce = %v", err)
+	}
+}
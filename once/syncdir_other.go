@@ -0,0 +1,8 @@
+//go:build !unix
+
+package once
+
+// syncDir does nothing, since directories cannot be synced on this platform.
+func syncDir(string) error {
+	return nil
+}
@@ -0,0 +1,63 @@
+package once
+
+import "sync"
+
+type Times interface {
+	// Bool returns true at most n times until Reset.
+	Bool() bool
+
+	// Reset allows Bool to return true n times again.
+	Reset()
+
+	// Remaining returns the number of times Bool will still return true.
+	Remaining() int
+
+	Run(func())
+	Go(func())
+}
+
+var _ Times = (*timesImpl)(nil)
+
+type timesImpl struct {
+	mu   sync.Mutex
+	n    int
+	left int
+}
+
+func (t *timesImpl) Bool() bool {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.left > 0 {
+		t.left--
+		return true
+	}
+	return false
+}
+
+func (t *timesImpl) Reset() {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.left = t.n
+}
+
+func (t *timesImpl) Remaining() int {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.left
+}
+
+func (t *timesImpl) Run(f func()) {
+	if t.Bool() {
+		f()
+	}
+}
+
+func (t *timesImpl) Go(f func()) {
+	if t.Bool() {
+		go f()
+	}
+}
+
+func NewTimes(n int) Times {
+	return &timesImpl{n: n, left: n}
+}
@@ -0,0 +1,148 @@
+package sliceutils
+
+import (
+	"context"
+	"time"
+)
+
+type batchOpts struct {
+	retries int
+	backoff func(attempt int) time.Duration
+	onRetry func(attempt int, err error) bool
+}
+
+type BatchOpt func(*batchOpts)
+
+// WithRetry retries a failed sink call up to n times, waiting backoff(attempt)
+// before each retry. The attempts are counted from 1. backoff may be nil.
+func WithRetry(n int, backoff func(attempt int) time.Duration) BatchOpt {
+	return func(t *batchOpts) {
+		t.retries = n
+		t.backoff = backoff
+	}
+}
+
+// WithRetryHook sets a function called with the error before each retry.
+// If it returns false, the error is returned without retrying.
+func WithRetryHook(f func(attempt int, err error) bool) BatchOpt {
+	return func(t *batchOpts) {
+		t.onRetry = f
+	}
+}
+
+func newBatchOpts(opts []BatchOpt) batchOpts {
+	var t batchOpts
+	for _, opt := range opts {
+		opt(&t)
+	}
+	return t
+}
+
+// sendBatch calls sink with the batch, retrying as configured by t.
+func sendBatch[T any](ctx context.Context, t *batchOpts, batch []T, sink func(context.Context, []T) error) error {
+	for attempt := 1; ; attempt++ {
+		err := sink(ctx, batch)
+		if err == nil || attempt > t.retries || ctx.Err() != nil {
+			return err
+		}
+		if t.onRetry != nil && !t.onRetry(attempt, err) {
+			return err
+		}
+		if t.backoff != nil {
+			timer := time.NewTimer(t.backoff(attempt))
+			select {
+			case <-timer.C:
+			case <-ctx.Done():
+				timer.Stop()
+				return err
+			}
+		}
+	}
+}
+
+// Batch calls sink with consecutive batches of size elements of s, the last one
+// may be shorter. A size < 1 is treated as 1, like in [NewBatcher]. It stops at
+// the first error that is left after the retries or when ctx is done.
+// The batches share the backing array of s.
+func Batch[T any](ctx context.Context, s []T, size int, sink func(context.Context, []T) error, opts ...BatchOpt) error {
+	t := newBatchOpts(opts)
+	for _, batch := range Chunk(s, max(size, 1)) {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		if err := sendBatch(ctx, &t, batch, sink); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Batcher collects values from a chan into batches and passes them to a sink.
+// A batch is sent when it has Size values or when Interval has passed since
+// its first value was received.
+//
+// The sink is called from the goroutine running Run, so while it is busy no values
+// are read and senders block on the chan, which gives backpressure.
+type Batcher[T any] struct {
+	size     int
+	interval time.Duration
+	sink     func(context.Context, []T) error
+	opts     batchOpts
+}
+
+// Run reads values from in until it is closed, then flushes the last batch and
+// returns <nil>. If ctx is done, Run returns its error and the pending values
+// are dropped. If the sink fails after the retries, Run returns its error.
+//
+// The batch passed to the sink is reused, so the sink must not keep it.
+func (b *Batcher[T]) Run(ctx context.Context, in <-chan T) error {
+	batch := make([]T, 0, b.size)
+	timer := time.NewTimer(b.interval)
+	timer.Stop()
+	defer timer.Stop()
+
+	flush := func() error {
+		timer.Stop()
+		if len(batch) == 0 {
+			return nil
+		}
+		err := sendBatch(ctx, &b.opts, batch, b.sink)
+		clear(batch)
+		batch = batch[:0]
+		return err
+	}
+
+	for {
+		select {
+		case v, ok := <-in:
+			if !ok {
+				return flush()
+			}
+			batch = append(batch, v)
+			if len(batch) == 1 && b.interval > 0 {
+				timer.Reset(b.interval)
+			}
+			if len(batch) >= b.size {
+				if err := flush(); err != nil {
+					return err
+				}
+			}
+		case <-timer.C:
+			if err := flush(); err != nil {
+				return err
+			}
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+// NewBatcher returns a [Batcher] that sends batches of up to size values,
+// or fewer if interval passes first. A size < 1 is treated as 1, like in [Batch].
+// An interval <= 0 disables the time limit.
+func NewBatcher[T any](size int, interval time.Duration, sink func(context.Context, []T) error, opts ...BatchOpt) *Batcher[T] {
+	return &Batcher[T]{
+		size: max(size, 1), interval: interval,
+		sink: sink, opts: newBatchOpts(opts),
+	}
+}
@@ -0,0 +1,65 @@
+package sliceutils
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+	"time"
+)
+
+func TestBatchSize(t *testing.T) {
+	var got [][]int
+	sink := func(_ context.Context, b []int) error {
+		got = append(got, slices.Clone(b))
+		return nil
+	}
+	if err := Batch(context.Background(), []int{1, 2, 3}, 0, sink); err != nil {
+		t.Fatal(err)
+	}
+	if len(got) != 3 {
+		t.Fatalf("size 0: got %v", got)
+	}
+}
+
+func TestBatchRetry(t *testing.T) {
+	calls := 0
+	var retries []int
+	err := Batch(context.Background(), []int{1, 2, 3}, 2, func(context.Context, []int) error {
+		if calls++; calls < 3 {
+			return errors.New("fail")
+		}
+		return nil
+	}, WithRetry(3, nil), WithRetryHook(func(attempt int, _ error) bool {
+		retries = append(retries, attempt)
+		return true
+	}))
+	if err != nil || calls != 4 || !slices.Equal(retries, []int{1, 2}) {
+		t.Fatalf("err %v, calls %d, retries %v", err, calls, retries)
+	}
+}
+
+func TestBatcher(t *testing.T) {
+	var got [][]int
+	b := NewBatcher(3, 20*time.Millisecond, func(_ context.Context, batch []int) error {
+		got = append(got, slices.Clone(batch))
+		return nil
+	})
+	in := make(chan int)
+	done := make(chan error, 1)
+	go func() { done <- b.Run(context.Background(), in) }()
+
+	for i := range 4 {
+		in <- i
+	}
+	time.Sleep(50 * time.Millisecond) // the interval flushes [3]
+	in <- 4
+	close(in) // the final flush sends [4]
+	if err := <-done; err != nil {
+		t.Fatal(err)
+	}
+	want := [][]int{{0, 1, 2}, {3}, {4}}
+	if !slices.EqualFunc(got, want, slices.Equal) {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+}
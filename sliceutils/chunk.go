@@ -0,0 +1,45 @@
+package sliceutils
+
+// Chunk splits s into sub-slices of n elements, the last one may be shorter.
+// The sub-slices share the backing array of s but have their capacity capped,
+// so appending to them does not overwrite s. Chunk panics if n < 1.
+func Chunk[T any](s []T, n int) [][]T {
+	if n < 1 {
+		panic("sliceutils: chunk size must be positive")
+	}
+	r := make([][]T, 0, (len(s)+n-1)/n)
+	for i := 0; i < len(s); i += n {
+		end := min(i+n, len(s))
+		r = append(r, s[i:end:end])
+	}
+	return r
+}
+
+// Window returns the sliding windows of n elements starting every step elements.
+// Windows shorter than n at the end are dropped. Like with Chunk, the windows share
+// the backing array of s. Window panics if n < 1 or step < 1.
+func Window[T any](s []T, n, step int) [][]T {
+	if n < 1 || step < 1 {
+		panic("sliceutils: window size and step must be positive")
+	}
+	if len(s) < n {
+		return [][]T{}
+	}
+	r := make([][]T, 0, (len(s)-n)/step+1)
+	for i := 0; i+n <= len(s); i += step {
+		r = append(r, s[i:i+n:i+n])
+	}
+	return r
+}
+
+func Flatten[T any](s [][]T) []T {
+	n := 0
+	for _, v := range s {
+		n += len(v)
+	}
+	r := make([]T, 0, n)
+	for _, v := range s {
+		r = append(r, v...)
+	}
+	return r
+}
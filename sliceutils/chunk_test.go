@@ -0,0 +1,74 @@
+package sliceutils
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestChunk(t *testing.T) {
+	s := []int{1, 2, 3, 4, 5}
+	got := Chunk(s, 2)
+	if !equalAll(got, [][]int{{1, 2}, {3, 4}, {5}}) {
+		t.Fatalf("Chunk = %v", got)
+	}
+	// Appending to a chunk must not overwrite the next one.
+	_ = append(got[0], 100)
+	if !slices.Equal(s, []int{1, 2, 3, 4, 5}) {
+		t.Fatalf("append to a chunk changed s: %v", s)
+	}
+	if got := Chunk(s, 10); !equalAll(got, [][]int{s}) {
+		t.Fatalf("Chunk(s, 10) = %v", got)
+	}
+	if got := Chunk([]int{}, 3); len(got) != 0 {
+		t.Fatalf("Chunk of an empty slice = %v", got)
+	}
+}
+
+func TestWindow(t *testing.T) {
+	s := []int{1, 2, 3, 4, 5}
+	if got := Window(s, 3, 1); !equalAll(got, [][]int{{1, 2, 3}, {2, 3, 4}, {3, 4, 5}}) {
+		t.Fatalf("Window(s, 3, 1) = %v", got)
+	}
+	// The short window at the end is dropped.
+	got := Window(s, 2, 2)
+	if !equalAll(got, [][]int{{1, 2}, {3, 4}}) {
+		t.Fatalf("Window(s, 2, 2) = %v", got)
+	}
+	_ = append(got[0], 100)
+	if !slices.Equal(s, []int{1, 2, 3, 4, 5}) {
+		t.Fatalf("append to a window changed s: %v", s)
+	}
+	if got := Window(s, 6, 1); got == nil || len(got) != 0 {
+		t.Fatalf("Window with len(s) < n = %#v, want an empty slice", got)
+	}
+	if got := Window(s, 5, 3); !equalAll(got, [][]int{s}) {
+		t.Fatalf("Window(s, 5, 3) = %v", got)
+	}
+}
+
+func TestChunkWindowPanic(t *testing.T) {
+	for name, f := range map[string]func(){
+		"Chunk 0":       func() { Chunk([]int{1}, 0) },
+		"Chunk -1":      func() { Chunk([]int{1}, -1) },
+		"Window size 0": func() { Window([]int{1}, 0, 1) },
+		"Window step 0": func() { Window([]int{1}, 1, 0) },
+	} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Fatalf("%s did not panic", name)
+				}
+			}()
+			f()
+		}()
+	}
+}
+
+func TestFlatten(t *testing.T) {
+	if got := Flatten([][]int{{1, 2}, nil, {3}}); !slices.Equal(got, []int{1, 2, 3}) {
+		t.Fatalf("Flatten = %v", got)
+	}
+	if got := Flatten(Chunk([]int{1, 2, 3, 4, 5}, 2)); !slices.Equal(got, []int{1, 2, 3, 4, 5}) {
+		t.Fatalf("Flatten(Chunk) = %v", got)
+	}
+}
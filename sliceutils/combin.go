@@ -0,0 +1,139 @@
+package sliceutils
+
+import "iter"
+
+// The generators below reuse one buffer for every yielded slice, so the slice
+// is only valid until the next iteration. Use slices.Clone to keep it.
+
+// CartesianProduct yields every combination of one element from each slice.
+// The last slice changes fastest, like nested loops:
+//
+//	CartesianProduct([]int{1, 2}, []int{3, 4}) // [1 3] [1 4] [2 3] [2 4]
+//
+// It yields nothing if any slice is empty, and one empty slice if there are no slices.
+func CartesianProduct[T any](ss ...[]T) iter.Seq[[]T] {
+	return func(yield func([]T) bool) {
+		for _, s := range ss {
+			if len(s) == 0 {
+				return
+			}
+		}
+		idx := make([]int, len(ss))
+		buf := make([]T, len(ss))
+		for i, s := range ss {
+			buf[i] = s[0]
+		}
+		for {
+			if !yield(buf) {
+				return
+			}
+			i := len(ss) - 1
+			for ; i >= 0; i-- {
+				if idx[i]++; idx[i] < len(ss[i]) {
+					buf[i] = ss[i][idx[i]]
+					break
+				}
+				idx[i] = 0
+				buf[i] = ss[i][0]
+			}
+			if i < 0 {
+				return
+			}
+		}
+	}
+}
+
+// Permutations yields every ordering of s in lexicographic order of the positions
+// in s, so a sorted s gives the permutations in sorted order:
+//
+//	Permutations([]int{1, 2, 3}) // [1 2 3] [1 3 2] [2 1 3] [2 3 1] [3 1 2] [3 2 1]
+//
+// Equal elements are treated as distinct.
+func Permutations[T any](s []T) iter.Seq[[]T] {
+	return func(yield func([]T) bool) {
+		idx := make([]int, len(s))
+		for i := range idx {
+			idx[i] = i
+		}
+		buf := make([]T, len(s))
+		for {
+			for i, j := range idx {
+				buf[i] = s[j]
+			}
+			if !yield(buf) || !nextPermutation(idx) {
+				return
+			}
+		}
+	}
+}
+
+func nextPermutation(idx []int) bool {
+	i := len(idx) - 2
+	for i >= 0 && idx[i] >= idx[i+1] {
+		i--
+	}
+	if i < 0 {
+		return false
+	}
+	j := len(idx) - 1
+	for idx[j] <= idx[i] {
+		j--
+	}
+	idx[i], idx[j] = idx[j], idx[i]
+	for l, r := i+1, len(idx)-1; l < r; l, r = l+1, r-1 {
+		idx[l], idx[r] = idx[r], idx[l]
+	}
+	return true
+}
+
+// Combinations yields every selection of k elements of s keeping their order in s,
+// in lexicographic order of the positions:
+//
+//	Combinations([]int{1, 2, 3}, 2) // [1 2] [1 3] [2 3]
+func Combinations[T any](s []T, k int) iter.Seq[[]T] {
+	return func(yield func([]T) bool) {
+		if k < 0 || k > len(s) {
+			return
+		}
+		idx := make([]int, k)
+		for i := range idx {
+			idx[i] = i
+		}
+		buf := make([]T, k)
+		for {
+			for i, j := range idx {
+				buf[i] = s[j]
+			}
+			if !yield(buf) {
+				return
+			}
+			i := k - 1
+			for i >= 0 && idx[i] == len(s)-k+i {
+				i--
+			}
+			if i < 0 {
+				return
+			}
+			idx[i]++
+			for j := i + 1; j < k; j++ {
+				idx[j] = idx[j-1] + 1
+			}
+		}
+	}
+}
+
+// PowerSet yields every subset of s by increasing size, and within each size
+// in the order of [Combinations]:
+//
+//	PowerSet([]int{1, 2}) // [] [1] [2] [1 2]
+func PowerSet[T any](s []T) iter.Seq[[]T] {
+	return func(yield func([]T) bool) {
+		for k := 0; k <= len(s); k++ {
+			for c := range Combinations(s, k) {
+				if !yield(c) {
+					return
+				}
+			}
+		}
+	}
+}
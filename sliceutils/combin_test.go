@@ -0,0 +1,106 @@
+package sliceutils
+
+import (
+	"iter"
+	"slices"
+	"testing"
+)
+
+// collect clones every yielded slice, since the generators reuse their buffer.
+func collect[T any](seq iter.Seq[[]T]) [][]T {
+	var r [][]T
+	for s := range seq {
+		r = append(r, slices.Clone(s))
+	}
+	return r
+}
+
+func equalAll[T comparable](a, b [][]T) bool {
+	return slices.EqualFunc(a, b, slices.Equal)
+}
+
+func TestCartesianProduct(t *testing.T) {
+	got := collect(CartesianProduct([]int{1, 2}, []int{3}, []int{4, 5}))
+	want := [][]int{{1, 3, 4}, {1, 3, 5}, {2, 3, 4}, {2, 3, 5}}
+	if !equalAll(got, want) {
+		t.Fatalf("CartesianProduct = %v", got)
+	}
+	if got := collect(CartesianProduct[int]()); !equalAll(got, [][]int{{}}) {
+		t.Fatalf("CartesianProduct() = %v, want one empty slice", got)
+	}
+	if got := collect(CartesianProduct([]int{1, 2}, []int{}, []int{3})); len(got) != 0 {
+		t.Fatalf("CartesianProduct with an empty slice = %v", got)
+	}
+}
+
+func TestPermutations(t *testing.T) {
+	got := collect(Permutations([]int{1, 2, 3}))
+	want := [][]int{{1, 2, 3}, {1, 3, 2}, {2, 1, 3}, {2, 3, 1}, {3, 1, 2}, {3, 2, 1}}
+	if !equalAll(got, want) {
+		t.Fatalf("Permutations = %v", got)
+	}
+	// Equal elements are distinct, so there are still n! permutations.
+	if got := collect(Permutations([]int{1, 1, 2})); len(got) != 6 {
+		t.Fatalf("Permutations with duplicates = %v", got)
+	}
+	if got := collect(Permutations[int](nil)); !equalAll(got, [][]int{{}}) {
+		t.Fatalf("Permutations(nil) = %v, want one empty slice", got)
+	}
+}
+
+func TestCombinations(t *testing.T) {
+	s := []int{1, 2, 3, 4}
+	got := collect(Combinations(s, 2))
+	want := [][]int{{1, 2}, {1, 3}, {1, 4}, {2, 3}, {2, 4}, {3, 4}}
+	if !equalAll(got, want) {
+		t.Fatalf("Combinations(s, 2) = %v", got)
+	}
+	if got := collect(Combinations(s, 0)); !equalAll(got, [][]int{{}}) {
+		t.Fatalf("Combinations(s, 0) = %v", got)
+	}
+	if got := collect(Combinations(s, 4)); !equalAll(got, [][]int{s}) {
+		t.Fatalf("Combinations(s, len) = %v", got)
+	}
+	for _, k := range []int{5, -1} {
+		if got := collect(Combinations(s, k)); len(got) != 0 {
+			t.Fatalf("Combinations(s, %d) = %v", k, got)
+		}
+	}
+}
+
+func TestPowerSet(t *testing.T) {
+	got := collect(PowerSet([]int{1, 2, 3}))
+	want := [][]int{{}, {1}, {2}, {3}, {1, 2}, {1, 3}, {2, 3}, {1, 2, 3}}
+	if !equalAll(got, want) {
+		t.Fatalf("PowerSet = %v", got)
+	}
+	if got := collect(PowerSet[int](nil)); !equalAll(got, [][]int{{}}) {
+		t.Fatalf("PowerSet(nil) = %v", got)
+	}
+}
+
+func TestCombinBufferReuse(t *testing.T) {
+	s := []int{1, 2, 3}
+	for name, seq := range map[string]iter.Seq[[]int]{
+		"CartesianProduct": CartesianProduct(s, s),
+		"Permutations":     Permutations(s),
+		"Combinations":     Combinations(s, 2),
+	} {
+		var first *int
+		n := 0
+		for v := range seq {
+			if first == nil {
+				first = &v[0]
+			} else if &v[0] != first {
+				t.Fatalf("%s: yielded a new backing array", name)
+			}
+			n++
+			if n == 3 {
+				break
+			}
+		}
+		if n != 3 {
+			t.Fatalf("%s: yielded %d slices before break", name, n)
+		}
+	}
+}
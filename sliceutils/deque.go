@@ -0,0 +1,169 @@
+package sliceutils
+
+import (
+	"iter"
+	"slices"
+	"sync"
+)
+
+// Deque is a double-ended queue backed by a growable ring buffer.
+// Pushing and popping at both ends is O(1) amortized.
+type Deque[T any] struct {
+	buf  []T
+	head int
+	n    int
+}
+
+func (d *Deque[T]) grow() {
+	buf := make([]T, max(2*len(d.buf), 8))
+	for i := range d.n {
+		buf[i] = d.buf[(d.head+i)%len(d.buf)]
+	}
+	d.buf, d.head = buf, 0
+}
+
+func (d *Deque[T]) PushBack(v T) {
+	if d.n == len(d.buf) {
+		d.grow()
+	}
+	d.buf[(d.head+d.n)%len(d.buf)] = v
+	d.n++
+}
+
+func (d *Deque[T]) PushFront(v T) {
+	if d.n == len(d.buf) {
+		d.grow()
+	}
+	d.head = (d.head - 1 + len(d.buf)) % len(d.buf)
+	d.buf[d.head] = v
+	d.n++
+}
+
+func (d *Deque[T]) PopFront() (v T, ok bool) {
+	if d.n == 0 {
+		return v, false
+	}
+	v = d.buf[d.head]
+	var zero T
+	d.buf[d.head] = zero
+	d.head = (d.head + 1) % len(d.buf)
+	d.n--
+	return v, true
+}
+
+func (d *Deque[T]) PopBack() (v T, ok bool) {
+	if d.n == 0 {
+		return v, false
+	}
+	i := (d.head + d.n - 1) % len(d.buf)
+	v = d.buf[i]
+	var zero T
+	d.buf[i] = zero
+	d.n--
+	return v, true
+}
+
+// At returns the i-th element counting from the front.
+func (d *Deque[T]) At(i int) (v T, ok bool) {
+	if i < 0 || i >= d.n {
+		return v, false
+	}
+	return d.buf[(d.head+i)%len(d.buf)], true
+}
+
+func (d *Deque[T]) Front() (T, bool) { return d.At(0) }
+func (d *Deque[T]) Back() (T, bool)  { return d.At(d.n - 1) }
+func (d *Deque[T]) Len() int         { return d.n }
+
+func (d *Deque[T]) Clear() {
+	clear(d.buf)
+	d.head, d.n = 0, 0
+}
+
+// All iterates from the front to the back.
+// The deque must not be modified during the iteration.
+func (d *Deque[T]) All() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		for i := range d.n {
+			if !yield(i, d.buf[(d.head+i)%len(d.buf)]) {
+				return
+			}
+		}
+	}
+}
+
+// Slice returns a copy of the elements from the front to the back.
+func (d *Deque[T]) Slice() []T {
+	s := make([]T, d.n)
+	for i, v := range d.All() {
+		s[i] = v
+	}
+	return s
+}
+
+func NewDeque[T any]() *Deque[T] {
+	return &Deque[T]{}
+}
+
+// SyncDeque is a [Deque] that is safe for concurrent use.
+type SyncDeque[T any] struct {
+	mu sync.Mutex
+	d  Deque[T]
+}
+
+func (t *SyncDeque[T]) PushBack(v T) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.d.PushBack(v)
+}
+
+func (t *SyncDeque[T]) PushFront(v T) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.d.PushFront(v)
+}
+
+func (t *SyncDeque[T]) PopFront() (T, bool) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.d.PopFront()
+}
+
+func (t *SyncDeque[T]) PopBack() (T, bool) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.d.PopBack()
+}
+
+func (t *SyncDeque[T]) At(i int) (T, bool) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.d.At(i)
+}
+
+func (t *SyncDeque[T]) Len() int {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.d.Len()
+}
+
+func (t *SyncDeque[T]) Clear() {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.d.Clear()
+}
+
+func (t *SyncDeque[T]) Slice() []T {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.d.Slice()
+}
+
+// All iterates over a snapshot of the deque.
+func (t *SyncDeque[T]) All() iter.Seq2[int, T] {
+	return slices.All(t.Slice())
+}
+
+func NewSyncDeque[T any]() *SyncDeque[T] {
+	return &SyncDeque[T]{}
+}
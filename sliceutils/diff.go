@@ -0,0 +1,244 @@
+package sliceutils
+
+import (
+	"fmt"
+	"strings"
+)
+
+type EditOp uint8
+
+const (
+	EditKeep EditOp = iota
+	EditDelete
+	EditInsert
+)
+
+func (op EditOp) String() string {
+	switch op {
+	case EditKeep:
+		return "keep"
+	case EditDelete:
+		return "delete"
+	case EditInsert:
+		return "insert"
+	}
+	return fmt.Sprintf("EditOp(%d)", op)
+}
+
+// Edit is a single step of an edit script. A and B are the positions in the old
+// and the new slice at this step; Value is a[A] for keep and delete, and b[B] for insert.
+type Edit[T any] struct {
+	Op    EditOp
+	A, B  int
+	Value T
+}
+
+// Diff returns a minimal edit script that turns a into b.
+func Diff[T comparable](a, b []T) []Edit[T] {
+	return DiffFunc(a, b, func(x, y T) bool { return x == y })
+}
+
+// DiffFunc is like Diff but compares the elements with eq.
+// It uses the linear-space variant of the Myers algorithm, which runs in
+// O((N+M)D) time and O(N+M) space for D differences.
+func DiffFunc[T any](a, b []T, eq func(x, y T) bool) []Edit[T] {
+	edits := make([]Edit[T], 0, max(len(a), len(b)))
+	return diff(edits, a, b, 0, 0, eq)
+}
+
+// diff appends the edit script for a and b, with positions shifted by offA and offB.
+func diff[T any](edits []Edit[T], a, b []T, offA, offB int, eq func(x, y T) bool) []Edit[T] {
+	// The common prefix and suffix are kept as is.
+	pre := 0
+	for pre < len(a) && pre < len(b) && eq(a[pre], b[pre]) {
+		edits = append(edits, Edit[T]{EditKeep, offA + pre, offB + pre, a[pre]})
+		pre++
+	}
+	suf := 0
+	for suf < len(a)-pre && suf < len(b)-pre && eq(a[len(a)-1-suf], b[len(b)-1-suf]) {
+		suf++
+	}
+	ma, mb := a[pre:len(a)-suf], b[pre:len(b)-suf]
+	offMA, offMB := offA+pre, offB+pre
+
+	switch {
+	case len(ma) == 0:
+		for j, v := range mb {
+			edits = append(edits, Edit[T]{EditInsert, offMA, offMB + j, v})
+		}
+	case len(mb) == 0:
+		for i, v := range ma {
+			edits = append(edits, Edit[T]{EditDelete, offMA + i, offMB, v})
+		}
+	default:
+		x, y, ok := middleSnake(ma, mb, eq)
+		if !ok {
+			for i, v := range ma {
+				edits = append(edits, Edit[T]{EditDelete, offMA + i, offMB, v})
+			}
+			for j, v := range mb {
+				edits = append(edits, Edit[T]{EditInsert, offMA + len(ma), offMB + j, v})
+			}
+			break
+		}
+		edits = diff(edits, ma[:x], mb[:y], offMA, offMB, eq)
+		edits = diff(edits, ma[x:], mb[y:], offMA+x, offMB+y, eq)
+	}
+
+	for i := range suf {
+		ai, bi := len(a)-suf+i, len(b)-suf+i
+		edits = append(edits, Edit[T]{EditKeep, offA + ai, offB + bi, a[ai]})
+	}
+	return edits
+}
+
+// middleSnake runs the Myers search from both ends at once and returns a point
+// on an optimal edit path where the two searches meet. The slices must be non-empty
+// and must not share a prefix or a suffix. It returns false if a and b have
+// nothing in common.
+func middleSnake[T any](a, b []T, eq func(x, y T) bool) (int, int, bool) {
+	n, m := len(a), len(b)
+	maxD := (n + m + 1) / 2
+	off := maxD
+	vf := make([]int, 2*maxD+2)
+	vb := make([]int, 2*maxD+2)
+	for i := range vf {
+		vf[i], vb[i] = -1, -1
+	}
+	vf[off+1], vb[off+1] = 0, 0
+
+	delta := n - m
+	// If delta is odd, the paths can meet during a forward step, otherwise during a backward one.
+	front := delta%2 != 0
+	var fStart, fEnd, bStart, bEnd int
+	for d := 0; d < maxD; d++ {
+		for k := -d + fStart; k <= d-fEnd; k += 2 {
+			var x int
+			if k == -d || (k != d && vf[off+k-1] < vf[off+k+1]) {
+				x = vf[off+k+1]
+			} else {
+				x = vf[off+k-1] + 1
+			}
+			y := x - k
+			for x < n && y < m && eq(a[x], b[y]) {
+				x++
+				y++
+			}
+			vf[off+k] = x
+			switch {
+			case x > n:
+				fEnd += 2
+			case y > m:
+				fStart += 2
+			case front:
+				if kb := off + delta - k; kb >= 0 && kb < len(vb) && vb[kb] != -1 && x >= n-vb[kb] {
+					return x, y, true
+				}
+			}
+		}
+		for k := -d + bStart; k <= d-bEnd; k += 2 {
+			var x int
+			if k == -d || (k != d && vb[off+k-1] < vb[off+k+1]) {
+				x = vb[off+k+1]
+			} else {
+				x = vb[off+k-1] + 1
+			}
+			y := x - k
+			for x < n && y < m && eq(a[n-x-1], b[m-y-1]) {
+				x++
+				y++
+			}
+			vb[off+k] = x
+			switch {
+			case x > n:
+				bEnd += 2
+			case y > m:
+				bStart += 2
+			case !front:
+				if kf := off + delta - k; kf >= 0 && kf < len(vf) && vf[kf] != -1 {
+					fx := vf[kf]
+					if fx >= n-x {
+						return fx, off + fx - kf, true
+					}
+				}
+			}
+		}
+	}
+	return 0, 0, false
+}
+
+// UnifiedDiff renders the difference between a and b in the unified diff format
+// with the given number of context lines. It returns an empty string if a and b are equal.
+func UnifiedDiff(a, b []string, fromName, toName string, context int) string {
+	edits := Diff(a, b)
+	context = max(context, 0)
+
+	var sb strings.Builder
+	for i := 0; i < len(edits); {
+		// Find the next change and extend the hunk while the changes are close enough.
+		for i < len(edits) && edits[i].Op == EditKeep {
+			i++
+		}
+		if i == len(edits) {
+			break
+		}
+		start := max(i-context, 0)
+		end := i
+		for j := i + 1; j < len(edits); j++ {
+			if edits[j].Op == EditKeep {
+				continue
+			}
+			if j-end-1 > 2*context {
+				break
+			}
+			end = j
+		}
+		stop := min(end+context+1, len(edits))
+
+		if sb.Len() == 0 {
+			fmt.Fprintf(&sb, "--- %s\n+++ %s\n", fromName, toName)
+		}
+		writeHunk(&sb, edits[start:stop])
+		i = stop
+	}
+	return sb.String()
+}
+
+func writeHunk(sb *strings.Builder, edits []Edit[string]) {
+	var aCount, bCount int
+	for _, e := range edits {
+		switch e.Op {
+		case EditKeep:
+			aCount++
+			bCount++
+		case EditDelete:
+			aCount++
+		case EditInsert:
+			bCount++
+		}
+	}
+	fmt.Fprintf(sb, "@@ -%s +%s @@\n",
+		hunkRange(edits[0].A, aCount), hunkRange(edits[0].B, bCount))
+	for _, e := range edits {
+		switch e.Op {
+		case EditKeep:
+			sb.WriteByte(' ')
+		case EditDelete:
+			sb.WriteByte('-')
+		case EditInsert:
+			sb.WriteByte('+')
+		}
+		sb.WriteString(e.Value)
+		sb.WriteByte('\n')
+	}
+}
+
+func hunkRange(pos, count int) string {
+	if count == 0 {
+		return fmt.Sprintf("%d,0", pos)
+	}
+	if count == 1 {
+		return fmt.Sprintf("%d", pos+1)
+	}
+	return fmt.Sprintf("%d,%d", pos+1, count)
+}
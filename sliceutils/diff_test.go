@@ -0,0 +1,117 @@
+package sliceutils
+
+import (
+	"math/rand/v2"
+	"runtime"
+	"slices"
+	"strings"
+	"testing"
+)
+
+// lcsLen returns the length of the longest common subsequence by dynamic programming.
+func lcsLen(a, b []byte) int {
+	prev := make([]int, len(b)+1)
+	cur := make([]int, len(b)+1)
+	for i := range a {
+		for j := range b {
+			if a[i] == b[j] {
+				cur[j+1] = prev[j] + 1
+			} else {
+				cur[j+1] = max(prev[j+1], cur[j])
+			}
+		}
+		prev, cur = cur, prev
+	}
+	return prev[len(b)]
+}
+
+// checkEdits verifies that edits is a valid script from a to b and returns
+// the number of kept elements.
+func checkEdits(t *testing.T, a, b []byte, edits []Edit[byte]) int {
+	t.Helper()
+	var i, j, keep int
+	for _, e := range edits {
+		if e.A != i || e.B != j {
+			t.Fatalf("%q -> %q: edit %+v at position (%d, %d)", a, b, e, i, j)
+		}
+		switch e.Op {
+		case EditKeep:
+			if a[i] != b[j] || e.Value != a[i] {
+				t.Fatalf("%q -> %q: bad keep %+v", a, b, e)
+			}
+			i, j, keep = i+1, j+1, keep+1
+		case EditDelete:
+			if e.Value != a[i] {
+				t.Fatalf("%q -> %q: bad delete %+v", a, b, e)
+			}
+			i++
+		case EditInsert:
+			if e.Value != b[j] {
+				t.Fatalf("%q -> %q: bad insert %+v", a, b, e)
+			}
+			j++
+		}
+	}
+	if i != len(a) || j != len(b) {
+		t.Fatalf("%q -> %q: script ends at (%d, %d)", a, b, i, j)
+	}
+	return keep
+}
+
+func TestDiffMinimal(t *testing.T) {
+	r := rand.New(rand.NewPCG(1, 2))
+	for range 5000 {
+		a := make([]byte, r.IntN(20))
+		b := make([]byte, r.IntN(20))
+		alpha := 1 + r.IntN(4)
+		for i := range a {
+			a[i] = byte('a' + r.IntN(alpha))
+		}
+		for i := range b {
+			b[i] = byte('a' + r.IntN(alpha))
+		}
+		keep := checkEdits(t, a, b, Diff(a, b))
+		if want := lcsLen(a, b); keep != want {
+			t.Fatalf("%q -> %q: kept %d, LCS is %d", a, b, keep, want)
+		}
+	}
+}
+
+func TestDiffDisjointAllocs(t *testing.T) {
+	a := make([]int, 5000)
+	b := make([]int, 5000)
+	for i := range a {
+		a[i], b[i] = i, -i-1
+	}
+	var before, after runtime.MemStats
+	runtime.ReadMemStats(&before)
+	Diff(a, b)
+	runtime.ReadMemStats(&after)
+	if bytes := after.TotalAlloc - before.TotalAlloc; bytes > 10<<20 {
+		t.Fatalf("Diff allocated %d bytes for disjoint inputs", bytes)
+	}
+}
+
+func TestUnifiedDiff(t *testing.T) {
+	a := strings.Fields("1 2 3 4 5 6 7 8 9 10")
+	b := slices.Clone(a)
+	b[2] = "X"
+	b = append(b, "11")
+	want := `--- old
++++ new
+@@ -2,3 +2,3 @@
+ 2
+-3
++X
+ 4
+@@ -10 +10,2 @@
+ 10
++11
+`
+	if got := UnifiedDiff(a, b, "old", "new", 1); got != want {
+		t.Fatalf("got\n%s\nwant\n%s", got, want)
+	}
+	if got := UnifiedDiff(a, a, "old", "new", 3); got != "" {
+		t.Fatalf("equal inputs: got %q", got)
+	}
+}
@@ -0,0 +1,21 @@
+package sliceutils
+
+import (
+	"errors"
+	"fmt"
+)
+
+var ErrIndexOutOfRange = errors.New("index out of range")
+
+type IndexError struct {
+	Index int
+	Err   error
+}
+
+func (e *IndexError) Error() string {
+	return fmt.Sprintf("index %d: %v", e.Index, e.Err)
+}
+
+func (e *IndexError) Unwrap() error {
+	return e.Err
+}
@@ -0,0 +1,94 @@
+package sliceutils
+
+import "errors"
+
+// MapErr stops at the first error and returns it as an [*IndexError].
+func MapErr[T, R any](s []T, f func(T) (R, error)) ([]R, error) {
+	r := make([]R, len(s))
+	for i, v := range s {
+		var err error
+		if r[i], err = f(v); err != nil {
+			return nil, &IndexError{Index: i, Err: err}
+		}
+	}
+	return r, nil
+}
+
+// MapErrAll calls f for every element and joins all errors as [*IndexError] values.
+// The result holds zero values at the failed indices.
+func MapErrAll[T, R any](s []T, f func(T) (R, error)) ([]R, error) {
+	r := make([]R, len(s))
+	var errs []error
+	for i, v := range s {
+		res, err := f(v)
+		if err != nil {
+			errs = append(errs, &IndexError{Index: i, Err: err})
+			continue
+		}
+		r[i] = res
+	}
+	return r, errors.Join(errs...)
+}
+
+// FilterErr stops at the first error and returns it as an [*IndexError].
+func FilterErr[T any](s []T, f func(T) (bool, error)) ([]T, error) {
+	var r []T
+	for i, v := range s {
+		ok, err := f(v)
+		if err != nil {
+			return nil, &IndexError{Index: i, Err: err}
+		}
+		if ok {
+			r = append(r, v)
+		}
+	}
+	return r, nil
+}
+
+// FilterErrAll calls f for every element and joins all errors as [*IndexError] values.
+// The failed elements are left out of the result.
+func FilterErrAll[T any](s []T, f func(T) (bool, error)) ([]T, error) {
+	var r []T
+	var errs []error
+	for i, v := range s {
+		ok, err := f(v)
+		if err != nil {
+			errs = append(errs, &IndexError{Index: i, Err: err})
+			continue
+		}
+		if ok {
+			r = append(r, v)
+		}
+	}
+	return r, errors.Join(errs...)
+}
+
+// ReduceErr stops at the first error and returns the accumulator so far with
+// the error as an [*IndexError].
+func ReduceErr[T, A any](s []T, init A, f func(A, T) (A, error)) (A, error) {
+	acc := init
+	for i, v := range s {
+		next, err := f(acc, v)
+		if err != nil {
+			return acc, &IndexError{Index: i, Err: err}
+		}
+		acc = next
+	}
+	return acc, nil
+}
+
+// ReduceErrAll skips the failed elements, keeping the accumulator unchanged,
+// and joins all errors as [*IndexError] values.
+func ReduceErrAll[T, A any](s []T, init A, f func(A, T) (A, error)) (A, error) {
+	acc := init
+	var errs []error
+	for i, v := range s {
+		next, err := f(acc, v)
+		if err != nil {
+			errs = append(errs, &IndexError{Index: i, Err: err})
+			continue
+		}
+		acc = next
+	}
+	return acc, errors.Join(errs...)
+}
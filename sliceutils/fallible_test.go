@@ -0,0 +1,112 @@
+package sliceutils
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"testing"
+)
+
+var errOdd = errors.New("odd")
+
+func failOdd(v int) error {
+	if v%2 != 0 {
+		return fmt.Errorf("value %d: %w", v, errOdd)
+	}
+	return nil
+}
+
+// errIndices returns the indices of the [*IndexError] values joined in err.
+func errIndices(t *testing.T, err error) []int {
+	t.Helper()
+	joined, ok := err.(interface{ Unwrap() []error })
+	if !ok {
+		t.Fatalf("err = %v, want joined errors", err)
+	}
+	var r []int
+	for _, e := range joined.Unwrap() {
+		var ie *IndexError
+		if !errors.As(e, &ie) {
+			t.Fatalf("joined error %v is not an *IndexError", e)
+		}
+		r = append(r, ie.Index)
+	}
+	return r
+}
+
+func checkIndexError(t *testing.T, err error, index int) {
+	t.Helper()
+	var ie *IndexError
+	if !errors.As(err, &ie) || ie.Index != index || !errors.Is(err, errOdd) {
+		t.Fatalf("err = %v, want an *IndexError at %d wrapping errOdd", err, index)
+	}
+}
+
+func TestMapErr(t *testing.T) {
+	double := func(v int) (int, error) { return v * 2, failOdd(v) }
+
+	r, err := MapErr([]int{2, 4, 5, 7}, double)
+	checkIndexError(t, err, 2)
+	if r != nil {
+		t.Fatalf("MapErr result = %v on error", r)
+	}
+	if r, err := MapErr([]int{2, 4}, double); err != nil || !slices.Equal(r, []int{4, 8}) {
+		t.Fatalf("MapErr = %v, %v", r, err)
+	}
+
+	r, err = MapErrAll([]int{2, 3, 4, 5}, double)
+	checkIndexError(t, err, 1)
+	if got := errIndices(t, err); !slices.Equal(got, []int{1, 3}) {
+		t.Fatalf("MapErrAll failed indices = %v", got)
+	}
+	if !slices.Equal(r, []int{4, 0, 8, 0}) {
+		t.Fatalf("MapErrAll result = %v, want zero values at the failed indices", r)
+	}
+}
+
+func TestFilterErr(t *testing.T) {
+	big := func(v int) (bool, error) { return v > 2, failOdd(v) }
+
+	r, err := FilterErr([]int{2, 4, 5, 6}, big)
+	checkIndexError(t, err, 2)
+	if r != nil {
+		t.Fatalf("FilterErr result = %v on error", r)
+	}
+
+	r, err = FilterErrAll([]int{1, 2, 4, 5, 6}, big)
+	if got := errIndices(t, err); !slices.Equal(got, []int{0, 3}) {
+		t.Fatalf("FilterErrAll failed indices = %v", got)
+	}
+	if !errors.Is(err, errOdd) {
+		t.Fatalf("FilterErrAll err = %v", err)
+	}
+	if !slices.Equal(r, []int{4, 6}) {
+		t.Fatalf("FilterErrAll result = %v, want the failed elements left out", r)
+	}
+}
+
+func TestReduceErr(t *testing.T) {
+	sum := func(acc, v int) (int, error) {
+		if err := failOdd(v); err != nil {
+			return -1, err
+		}
+		return acc + v, nil
+	}
+
+	acc, err := ReduceErr([]int{2, 4, 5, 6}, 10, sum)
+	checkIndexError(t, err, 2)
+	if acc != 16 {
+		t.Fatalf("ReduceErr = %d, want the accumulator before the failure", acc)
+	}
+
+	acc, err = ReduceErrAll([]int{1, 2, 3, 4}, 10, sum)
+	if got := errIndices(t, err); !slices.Equal(got, []int{0, 2}) {
+		t.Fatalf("ReduceErrAll failed indices = %v", got)
+	}
+	if acc != 16 {
+		t.Fatalf("ReduceErrAll = %d, want the failed elements skipped", acc)
+	}
+	if acc, err := ReduceErrAll([]int{2}, 1, sum); err != nil || acc != 3 {
+		t.Fatalf("ReduceErrAll = %d, %v", acc, err)
+	}
+}
@@ -0,0 +1,51 @@
+package sliceutils
+
+func GroupBy[T any, K comparable](s []T, f func(T) K) map[K][]T {
+	r := make(map[K][]T)
+	for _, v := range s {
+		k := f(v)
+		r[k] = append(r[k], v)
+	}
+	return r
+}
+
+// Partition splits s into the elements that match f and the ones that do not.
+func Partition[T any](s []T, f func(T) bool) (match, rest []T) {
+	for _, v := range s {
+		if f(v) {
+			match = append(match, v)
+		} else {
+			rest = append(rest, v)
+		}
+	}
+	return match, rest
+}
+
+func CountBy[T any, K comparable](s []T, f func(T) K) map[K]int {
+	r := make(map[K]int)
+	for _, v := range s {
+		r[f(v)]++
+	}
+	return r
+}
+
+// Associate builds a map from the key-value pairs returned by f.
+// Later elements overwrite earlier ones with the same key.
+func Associate[T any, K comparable, V any](s []T, f func(T) (K, V)) map[K]V {
+	r := make(map[K]V, len(s))
+	for _, v := range s {
+		k, val := f(v)
+		r[k] = val
+	}
+	return r
+}
+
+// KeyBy builds a map of elements by key. Later elements overwrite earlier ones
+// with the same key.
+func KeyBy[T any, K comparable](s []T, f func(T) K) map[K]T {
+	r := make(map[K]T, len(s))
+	for _, v := range s {
+		r[f(v)] = v
+	}
+	return r
+}
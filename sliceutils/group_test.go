@@ -0,0 +1,45 @@
+package sliceutils
+
+import (
+	"maps"
+	"slices"
+	"testing"
+)
+
+func isEven(v int) bool { return v%2 == 0 }
+
+func TestGroupBy(t *testing.T) {
+	got := GroupBy([]int{1, 2, 3, 4, 5}, isEven)
+	if !slices.Equal(got[true], []int{2, 4}) || !slices.Equal(got[false], []int{1, 3, 5}) {
+		t.Fatalf("GroupBy = %v", got)
+	}
+	if got := GroupBy([]int{}, isEven); len(got) != 0 {
+		t.Fatalf("GroupBy of an empty slice = %v", got)
+	}
+}
+
+func TestPartition(t *testing.T) {
+	match, rest := Partition([]int{1, 2, 3, 4, 5}, isEven)
+	if !slices.Equal(match, []int{2, 4}) || !slices.Equal(rest, []int{1, 3, 5}) {
+		t.Fatalf("Partition = %v, %v", match, rest)
+	}
+}
+
+func TestCountBy(t *testing.T) {
+	got := CountBy([]int{1, 2, 3, 4, 5}, isEven)
+	if !maps.Equal(got, map[bool]int{true: 2, false: 3}) {
+		t.Fatalf("CountBy = %v", got)
+	}
+}
+
+func TestAssociateKeyBy(t *testing.T) {
+	s := []sortItem{{1, "a"}, {2, "b"}, {1, "c"}}
+	got := Associate(s, func(v sortItem) (int, string) { return v.key, v.name })
+	if !maps.Equal(got, map[int]string{1: "c", 2: "b"}) {
+		t.Fatalf("Associate = %v, want later elements to win", got)
+	}
+	byKey := KeyBy(s, itemKey)
+	if !maps.Equal(byKey, map[int]sortItem{1: {1, "c"}, 2: {2, "b"}}) {
+		t.Fatalf("KeyBy = %v, want later elements to win", byKey)
+	}
+}
@@ -0,0 +1,62 @@
+package sliceutils
+
+// minHeap is a binary heap ordered by cmp, with the smallest element at the top.
+type minHeap[T any] struct {
+	s   []T
+	cmp func(a, b T) int
+}
+
+func (h *minHeap[T]) Len() int { return len(h.s) }
+func (h *minHeap[T]) Top() T   { return h.s[0] }
+
+func (h *minHeap[T]) Push(v T) {
+	h.s = append(h.s, v)
+	h.up(len(h.s) - 1)
+}
+
+func (h *minHeap[T]) Pop() T {
+	v := h.s[0]
+	n := len(h.s) - 1
+	h.s[0] = h.s[n]
+	var zero T
+	h.s[n] = zero
+	h.s = h.s[:n]
+	h.down(0)
+	return v
+}
+
+// Replace replaces the top element with v. It is cheaper than Pop followed by Push.
+func (h *minHeap[T]) Replace(v T) {
+	h.s[0] = v
+	h.down(0)
+}
+
+func (h *minHeap[T]) up(i int) {
+	for i > 0 {
+		p := (i - 1) / 2
+		if h.cmp(h.s[i], h.s[p]) >= 0 {
+			return
+		}
+		h.s[i], h.s[p] = h.s[p], h.s[i]
+		i = p
+	}
+}
+
+func (h *minHeap[T]) down(i int) {
+	n := len(h.s)
+	for {
+		l := 2*i + 1
+		if l >= n {
+			return
+		}
+		m := l
+		if r := l + 1; r < n && h.cmp(h.s[r], h.s[l]) < 0 {
+			m = r
+		}
+		if h.cmp(h.s[m], h.s[i]) >= 0 {
+			return
+		}
+		h.s[i], h.s[m] = h.s[m], h.s[i]
+		i = m
+	}
+}
@@ -0,0 +1,102 @@
+package sliceutils
+
+import (
+	"context"
+	"runtime"
+	"sync"
+	"sync/atomic"
+)
+
+type parallelOpts struct {
+	chunkSize int
+}
+
+type ParallelOpt func(*parallelOpts)
+
+// WithChunkSize sets the number of elements a worker takes at once.
+// By default, the slice is split into about 4 chunks per worker.
+func WithChunkSize(n int) ParallelOpt {
+	return func(t *parallelOpts) {
+		t.chunkSize = n
+	}
+}
+
+// parallelFor calls f for each index of a slice of length n using at most workers goroutines.
+// It stops at the first error or when ctx is done and returns that error.
+func parallelFor(ctx context.Context, n, workers int, f func(context.Context, int) error, opts []ParallelOpt) error {
+	if n == 0 {
+		return ctx.Err()
+	}
+	if workers <= 0 {
+		workers = runtime.GOMAXPROCS(0)
+	}
+	var t parallelOpts
+	for _, opt := range opts {
+		opt(&t)
+	}
+	chunk := t.chunkSize
+	if chunk <= 0 {
+		chunk = max(1, n/(workers*4))
+	}
+	chunks := (n + chunk - 1) / chunk
+	workers = min(workers, chunks)
+
+	ctx, cancel := context.WithCancelCause(ctx)
+	defer cancel(nil)
+
+	var next atomic.Int64
+	var wg sync.WaitGroup
+	for range workers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for {
+				c := int(next.Add(1) - 1)
+				if c >= chunks {
+					return
+				}
+				for i := c * chunk; i < min((c+1)*chunk, n); i++ {
+					if ctx.Err() != nil {
+						return
+					}
+					if err := f(ctx, i); err != nil {
+						cancel(err)
+						return
+					}
+				}
+			}
+		}()
+	}
+	wg.Wait()
+	return context.Cause(ctx)
+}
+
+func ParallelMap[T, R any](ctx context.Context, s []T, workers int, f func(context.Context, T) (R, error), opts ...ParallelOpt) ([]R, error) {
+	r := make([]R, len(s))
+	err := parallelFor(ctx, len(s), workers, func(ctx context.Context, i int) (err error) {
+		r[i], err = f(ctx, s[i])
+		return err
+	}, opts)
+	if err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+func ParallelFilter[T any](ctx context.Context, s []T, workers int, f func(context.Context, T) (bool, error), opts ...ParallelOpt) ([]T, error) {
+	keep := make([]bool, len(s))
+	err := parallelFor(ctx, len(s), workers, func(ctx context.Context, i int) (err error) {
+		keep[i], err = f(ctx, s[i])
+		return err
+	}, opts)
+	if err != nil {
+		return nil, err
+	}
+	var r []T
+	for i, v := range s {
+		if keep[i] {
+			r = append(r, v)
+		}
+	}
+	return r, nil
+}
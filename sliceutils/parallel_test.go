@@ -0,0 +1,146 @@
+package sliceutils
+
+import (
+	"context"
+	"errors"
+	"runtime"
+	"slices"
+	"sync/atomic"
+	"testing"
+)
+
+func TestParallelMapOrder(t *testing.T) {
+	s := ints(1000)
+	want := make([]int, len(s))
+	for i, v := range s {
+		want[i] = v * v
+	}
+	for _, chunk := range []int{0, 1, 7, 64, 1000, 5000} {
+		got, err := ParallelMap(context.Background(), s, 4, func(_ context.Context, v int) (int, error) {
+			return v * v, nil
+		}, WithChunkSize(chunk))
+		if err != nil || !slices.Equal(got, want) {
+			t.Fatalf("chunk %d: ParallelMap = %v, %v", chunk, got, err)
+		}
+
+		evens, err := ParallelFilter(context.Background(), s, 4, func(_ context.Context, v int) (bool, error) {
+			return v%2 == 0, nil
+		}, WithChunkSize(chunk))
+		if err != nil || len(evens) != len(s)/2 || !slices.IsSorted(evens) {
+			t.Fatalf("chunk %d: ParallelFilter = %v, %v", chunk, evens, err)
+		}
+	}
+}
+
+func TestParallelMapWorkerLimit(t *testing.T) {
+	const workers = 3
+	var active, peak atomic.Int32
+	_, err := ParallelMap(context.Background(), make([]int, 200), workers, func(context.Context, int) (int, error) {
+		n := active.Add(1)
+		for {
+			p := peak.Load()
+			if n <= p || peak.CompareAndSwap(p, n) {
+				break
+			}
+		}
+		runtime.Gosched()
+		active.Add(-1)
+		return 0, nil
+	}, WithChunkSize(1))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if p := peak.Load(); p > workers {
+		t.Fatalf("%d calls ran at once, want at most %d", p, workers)
+	}
+}
+
+func TestParallelMapFirstError(t *testing.T) {
+	errBoom := errors.New("boom")
+
+	// With one worker the chunks run in order, so nothing after the failing
+	// element may run.
+	var calls atomic.Int32
+	r, err := ParallelMap(context.Background(), make([]int, 100), 1, func(_ context.Context, _ int) (int, error) {
+		if calls.Add(1) == 10 {
+			return 0, errBoom
+		}
+		return 0, nil
+	}, WithChunkSize(3))
+	if !errors.Is(err, errBoom) || r != nil {
+		t.Fatalf("ParallelMap = %v, %v", r, err)
+	}
+	if n := calls.Load(); n != 10 {
+		t.Fatalf("f ran %d times, want 10", n)
+	}
+
+	// With several workers the others see the canceled context, and their
+	// errors do not replace the first one.
+	calls.Store(0)
+	_, err = ParallelFilter(context.Background(), ints(100), 4, func(ctx context.Context, v int) (bool, error) {
+		calls.Add(1)
+		if v == 0 {
+			return false, errBoom
+		}
+		<-ctx.Done()
+		return false, ctx.Err()
+	}, WithChunkSize(1))
+	if !errors.Is(err, errBoom) {
+		t.Fatalf("ParallelFilter err = %v", err)
+	}
+	if n := calls.Load(); n > 4 {
+		t.Fatalf("f ran %d times after the first error, want at most one call per worker", n)
+	}
+}
+
+func TestParallelMapCanceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	var calls atomic.Int32
+	_, err := ParallelMap(ctx, make([]int, 100), 4, func(context.Context, int) (int, error) {
+		calls.Add(1)
+		return 0, nil
+	})
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("err = %v", err)
+	}
+	if n := calls.Load(); n != 0 {
+		t.Fatalf("f ran %d times with a canceled context", n)
+	}
+
+	if _, err := ParallelMap(ctx, []int{}, 4, func(context.Context, int) (int, error) {
+		return 0, nil
+	}); !errors.Is(err, context.Canceled) {
+		t.Fatalf("empty input with a canceled context: err = %v", err)
+	}
+}
+
+func TestParallelMapEdgeCases(t *testing.T) {
+	double := func(_ context.Context, v int) (int, error) { return v * 2, nil }
+
+	got, err := ParallelMap(context.Background(), nil, 4, double)
+	if err != nil || len(got) != 0 {
+		t.Fatalf("empty input: %v, %v", got, err)
+	}
+	for _, workers := range []int{0, -1} {
+		got, err := ParallelMap(context.Background(), []int{1, 2, 3}, workers, double)
+		if err != nil || !slices.Equal(got, []int{2, 4, 6}) {
+			t.Fatalf("workers %d: %v, %v", workers, got, err)
+		}
+	}
+	evens, err := ParallelFilter(context.Background(), []int{}, 0, func(context.Context, int) (bool, error) {
+		return true, nil
+	})
+	if err != nil || len(evens) != 0 {
+		t.Fatalf("ParallelFilter on empty input: %v, %v", evens, err)
+	}
+}
+
+// ints returns 0, 1, ..., n-1.
+func ints(n int) []int {
+	s := make([]int, n)
+	for i := range s {
+		s[i] = i
+	}
+	return s
+}
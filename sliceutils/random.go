@@ -0,0 +1,142 @@
+package sliceutils
+
+import (
+	"errors"
+	"fmt"
+	"iter"
+	"math"
+	"math/rand/v2"
+)
+
+// The functions below take a *rand.Rand so the results can be made deterministic
+// with a seeded source. If r is nil, the global generator is used.
+
+func randIntN(r *rand.Rand, n int) int {
+	if r == nil {
+		return rand.IntN(n)
+	}
+	return r.IntN(n)
+}
+
+func randFloat64(r *rand.Rand) float64 {
+	if r == nil {
+		return rand.Float64()
+	}
+	return r.Float64()
+}
+
+// Shuffle shuffles s in place.
+func Shuffle[T any](s []T, r *rand.Rand) {
+	for i := len(s) - 1; i > 0; i-- {
+		j := randIntN(r, i+1)
+		s[i], s[j] = s[j], s[i]
+	}
+}
+
+// Sample returns k elements chosen uniformly from seq using reservoir sampling,
+// so seq is read once and its length does not have to be known.
+// If seq has fewer than k elements, all of them are returned.
+// The order of the result is not defined.
+func Sample[T any](seq iter.Seq[T], k int, r *rand.Rand) []T {
+	if k <= 0 {
+		return []T{}
+	}
+	res := make([]T, 0, k)
+	i := 0
+	for v := range seq {
+		if i < k {
+			res = append(res, v)
+		} else if j := randIntN(r, i+1); j < k {
+			res[j] = v
+		}
+		i++
+	}
+	return res
+}
+
+// Choice returns a random element of s. It returns false if s is empty.
+func Choice[T any](s []T, r *rand.Rand) (v T, ok bool) {
+	if len(s) == 0 {
+		return v, false
+	}
+	return s[randIntN(r, len(s))], true
+}
+
+// WeightedChooser picks elements with probabilities proportional to their weights.
+// It uses the alias method, so each pick is O(1) after O(n) setup.
+// The zero value has no values and Pick panics on it; use [NewWeightedChooser].
+type WeightedChooser[T any] struct {
+	values []T
+	prob   []float64
+	alias  []int
+}
+
+// Pick returns a random element.
+func (w *WeightedChooser[T]) Pick(r *rand.Rand) T {
+	i := randIntN(r, len(w.values))
+	if randFloat64(r) < w.prob[i] {
+		return w.values[i]
+	}
+	return w.values[w.alias[i]]
+}
+
+// NewWeightedChooser builds a [WeightedChooser] for the values and their weights.
+// The weights must be non-negative, finite and not all zero.
+func NewWeightedChooser[T any](values []T, weights []float64) (*WeightedChooser[T], error) {
+	if len(values) == 0 {
+		return nil, errors.New("no values")
+	}
+	if len(values) != len(weights) {
+		return nil, fmt.Errorf("%d values but %d weights", len(values), len(weights))
+	}
+	var sum float64
+	for i, w := range weights {
+		if w < 0 || math.IsNaN(w) || math.IsInf(w, 0) {
+			return nil, &IndexError{Index: i, Err: fmt.Errorf("invalid weight %v", w)}
+		}
+		sum += w
+	}
+	if sum == 0 {
+		return nil, errors.New("all weights are zero")
+	}
+
+	// Vose's alias method.
+	n := len(weights)
+	w := &WeightedChooser[T]{values: values, prob: make([]float64, n), alias: make([]int, n)}
+	scaled := make([]float64, n)
+	var small, large []int
+	for i, wt := range weights {
+		scaled[i] = wt * float64(n) / sum
+		if scaled[i] < 1 {
+			small = append(small, i)
+		} else {
+			large = append(large, i)
+		}
+	}
+	for len(small) > 0 && len(large) > 0 {
+		s, l := small[len(small)-1], large[len(large)-1]
+		small = small[:len(small)-1]
+		w.prob[s] = scaled[s]
+		w.alias[s] = l
+		scaled[l] -= 1 - scaled[s]
+		if scaled[l] < 1 {
+			large = large[:len(large)-1]
+			small = append(small, l)
+		}
+	}
+	// The rest have a probability of 1 up to rounding errors.
+	for _, i := range append(small, large...) {
+		w.prob[i] = 1
+	}
+	return w, nil
+}
+
+// WeightedChoice returns a random element of values with probability proportional
+// to its weight. To pick many times, build a [WeightedChooser] once instead.
+func WeightedChoice[T any](values []T, weights []float64, r *rand.Rand) (v T, err error) {
+	w, err := NewWeightedChooser(values, weights)
+	if err != nil {
+		return v, err
+	}
+	return w.Pick(r), nil
+}
@@ -0,0 +1,125 @@
+package sliceutils
+
+import (
+	"errors"
+	"math"
+	"math/rand/v2"
+	"slices"
+	"testing"
+)
+
+func newTestRand() *rand.Rand {
+	return rand.New(rand.NewPCG(1, 2))
+}
+
+func TestShuffle(t *testing.T) {
+	r := newTestRand()
+	s := ints(100)
+	Shuffle(s, r)
+	if slices.IsSorted(s) {
+		t.Fatal("Shuffle left the slice sorted")
+	}
+	if !slices.Equal(slices.Sorted(slices.Values(s)), ints(100)) {
+		t.Fatalf("Shuffle is not a permutation: %v", s)
+	}
+	Shuffle([]int{}, r)
+	Shuffle([]int{1}, r)
+}
+
+func TestSample(t *testing.T) {
+	r := newTestRand()
+	for _, tt := range []struct{ n, k, want int }{
+		{10, 3, 3},
+		{10, 10, 10},
+		{3, 10, 3},
+		{0, 5, 0},
+		{10, 0, 0},
+		{10, -1, 0},
+	} {
+		got := Sample(slices.Values(ints(tt.n)), tt.k, r)
+		if len(got) != tt.want {
+			t.Fatalf("Sample(%d elements, %d) returned %d", tt.n, tt.k, len(got))
+		}
+		slices.Sort(got)
+		if len(slices.Compact(got)) != tt.want {
+			t.Fatalf("Sample(%d elements, %d) returned duplicates", tt.n, tt.k)
+		}
+	}
+
+	// Each of n elements should be picked k/n of the time.
+	const n, k, trials = 10, 3, 30000
+	counts := make([]int, n)
+	for range trials {
+		for _, v := range Sample(slices.Values(ints(n)), k, r) {
+			counts[v]++
+		}
+	}
+	want := float64(trials * k / n)
+	for v, c := range counts {
+		if math.Abs(float64(c)-want) > 0.05*want {
+			t.Fatalf("element %d picked %d times, want about %.0f", v, c, want)
+		}
+	}
+}
+
+func TestChoice(t *testing.T) {
+	r := newTestRand()
+	if v, ok := Choice([]int(nil), r); ok || v != 0 {
+		t.Fatalf("Choice(nil) = %d, %v", v, ok)
+	}
+	if v, ok := Choice([]int{7}, r); !ok || v != 7 {
+		t.Fatalf("Choice([7]) = %d, %v", v, ok)
+	}
+}
+
+func TestNewWeightedChooserErrors(t *testing.T) {
+	values := []string{"a", "b"}
+	for name, tt := range map[string]struct {
+		values  []string
+		weights []float64
+		index   int // -1 if no *IndexError is expected
+	}{
+		"no values":       {nil, nil, -1},
+		"length mismatch": {values, []float64{1}, -1},
+		"negative":        {values, []float64{1, -1}, 1},
+		"NaN":             {values, []float64{math.NaN(), 1}, 0},
+		"+Inf":            {values, []float64{1, math.Inf(1)}, 1},
+		"-Inf":            {values, []float64{math.Inf(-1), 1}, 0},
+		"all zero":        {values, []float64{0, 0}, -1},
+	} {
+		w, err := NewWeightedChooser(tt.values, tt.weights)
+		if err == nil || w != nil {
+			t.Fatalf("%s: NewWeightedChooser = %v, %v", name, w, err)
+		}
+		var ie *IndexError
+		if got := errors.As(err, &ie); got != (tt.index >= 0) || got && ie.Index != tt.index {
+			t.Fatalf("%s: err = %v", name, err)
+		}
+		if _, err := WeightedChoice(tt.values, tt.weights, nil); err == nil {
+			t.Fatalf("%s: WeightedChoice succeeded", name)
+		}
+	}
+}
+
+func TestWeightedChooserFrequencies(t *testing.T) {
+	r := newTestRand()
+	weights := []float64{1, 0, 2, 3, 4}
+	w, err := NewWeightedChooser(ints(len(weights)), weights)
+	if err != nil {
+		t.Fatal(err)
+	}
+	const picks = 100000
+	counts := make([]int, len(weights))
+	for range picks {
+		counts[w.Pick(r)]++
+	}
+	for i, c := range counts {
+		want := weights[i] / 10
+		if got := float64(c) / picks; math.Abs(got-want) > 0.01 {
+			t.Fatalf("value %d picked with frequency %.4f, want %.2f", i, got, want)
+		}
+	}
+	if counts[1] != 0 {
+		t.Fatalf("zero-weight value picked %d times", counts[1])
+	}
+}
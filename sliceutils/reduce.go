@@ -0,0 +1,34 @@
+package sliceutils
+
+// Reduce combines the elements from left to right using the first element as
+// the initial value. It returns false if s is empty.
+func Reduce[T any](s []T, f func(T, T) T) (T, bool) {
+	var r T
+	if len(s) == 0 {
+		return r, false
+	}
+	r = s[0]
+	for _, v := range s[1:] {
+		r = f(r, v)
+	}
+	return r, true
+}
+
+func Fold[T, A any](s []T, init A, f func(A, T) A) A {
+	acc := init
+	for _, v := range s {
+		acc = f(acc, v)
+	}
+	return acc
+}
+
+// Scan is like Fold but returns every intermediate accumulator.
+func Scan[T, A any](s []T, init A, f func(A, T) A) []A {
+	r := make([]A, len(s))
+	acc := init
+	for i, v := range s {
+		acc = f(acc, v)
+		r[i] = acc
+	}
+	return r
+}
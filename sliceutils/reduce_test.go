@@ -0,0 +1,37 @@
+package sliceutils
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestReduce(t *testing.T) {
+	sub := func(a, b int) int { return a - b }
+	if got, ok := Reduce([]int{10, 1, 2}, sub); !ok || got != 7 {
+		t.Fatalf("Reduce = %d, %v, want left to right", got, ok)
+	}
+	if got, ok := Reduce([]int{5}, sub); !ok || got != 5 {
+		t.Fatalf("Reduce of one element = %d, %v", got, ok)
+	}
+	if _, ok := Reduce([]int{}, sub); ok {
+		t.Fatal("Reduce of an empty slice returned true")
+	}
+}
+
+func TestFoldScan(t *testing.T) {
+	concat := func(acc string, v string) string { return acc + v }
+	if got := Fold([]string{"a", "b", "c"}, ">", concat); got != ">abc" {
+		t.Fatalf("Fold = %q", got)
+	}
+	if got := Fold(nil, ">", concat); got != ">" {
+		t.Fatalf("Fold of nil = %q", got)
+	}
+
+	got := Scan([]int{1, 2, 3, 4}, 0, func(acc, v int) int { return acc + v })
+	if !slices.Equal(got, []int{1, 3, 6, 10}) {
+		t.Fatalf("Scan = %v", got)
+	}
+	if got := Scan([]int{}, 0, func(acc, v int) int { return acc + v }); len(got) != 0 {
+		t.Fatalf("Scan of an empty slice = %v", got)
+	}
+}
@@ -1,16 +1,116 @@
 package sliceutils
 
-import "slices"
+import (
+	"fmt"
+	"slices"
+)
 
+// RemoveIndex removes the element at i keeping the order.
+// It shifts the tail of s in place, so s itself is modified and shares
+// the backing array with the result. It panics if i is out of range.
 func RemoveIndex[T any](s []T, i int) []T {
 	return append(s[:i], s[i+1:]...)
 }
 
+// RemoveIndexFast removes the element at i by moving the last element in its place,
+// so the order is not kept. It modifies s in place. It panics if i is out of range.
 func RemoveIndexFast[T any](s []T, i int) []T {
 	s[i] = s[len(s)-1]
 	return s[:len(s)-1]
 }
 
+// RemoveIndexFastC is like RemoveIndexFast but works on a copy of s.
 func RemoveIndexFastC[T any](s []T, i int) []T {
 	return RemoveIndexFast(slices.Clone(s), i)
 }
+
+func checkIndex(i, n int) error {
+	if i < 0 || i >= n {
+		return fmt.Errorf("%w: index %d, length %d", ErrIndexOutOfRange, i, n)
+	}
+	return nil
+}
+
+// RemoveIndexErr is like RemoveIndex but returns [ErrIndexOutOfRange] instead of panicking.
+func RemoveIndexErr[T any](s []T, i int) ([]T, error) {
+	if err := checkIndex(i, len(s)); err != nil {
+		return s, err
+	}
+	return RemoveIndex(s, i), nil
+}
+
+// RemoveIndexFastErr is like RemoveIndexFast but returns [ErrIndexOutOfRange] instead of panicking.
+func RemoveIndexFastErr[T any](s []T, i int) ([]T, error) {
+	if err := checkIndex(i, len(s)); err != nil {
+		return s, err
+	}
+	return RemoveIndexFast(s, i), nil
+}
+
+// RemoveIndices removes the elements at the given indices in one pass keeping the order.
+// The indices may be unsorted and repeated. Like RemoveIndex, it modifies s in place;
+// the elements after the new length are zeroed. If any index is out of range,
+// s is left unchanged and [ErrIndexOutOfRange] is returned.
+//
+// It runs in O(len(s) + len(indices)) time. Sorted indices need no allocation,
+// unsorted ones are marked in a []bool of len(s) instead of being sorted.
+func RemoveIndices[T any](s []T, indices ...int) ([]T, error) {
+	for _, i := range indices {
+		if err := checkIndex(i, len(s)); err != nil {
+			return s, err
+		}
+	}
+	if len(indices) == 0 {
+		return s, nil
+	}
+
+	var drop func(i int) bool
+	if slices.IsSorted(indices) {
+		k := 0
+		drop = func(i int) bool {
+			for k < len(indices) && indices[k] < i {
+				k++
+			}
+			return k < len(indices) && indices[k] == i
+		}
+	} else {
+		marked := make([]bool, len(s))
+		for _, i := range indices {
+			marked[i] = true
+		}
+		drop = func(i int) bool { return marked[i] }
+	}
+
+	w := 0
+	for i := range s {
+		if drop(i) {
+			continue
+		}
+		s[w] = s[i]
+		w++
+	}
+	clear(s[w:])
+	return s[:w], nil
+}
+
+// RemoveRange removes the elements s[from:to] keeping the order. It modifies s in place;
+// the elements after the new length are zeroed. If the range is invalid,
+// s is left unchanged and [ErrIndexOutOfRange] is returned.
+func RemoveRange[T any](s []T, from, to int) ([]T, error) {
+	if from < 0 || to > len(s) || from > to {
+		return s, fmt.Errorf("%w: range [%d:%d], length %d", ErrIndexOutOfRange, from, to, len(s))
+	}
+	return slices.Delete(s, from, to), nil
+}
+
+// RemoveFunc removes the elements for which f returns true keeping the order.
+// It modifies s in place; the elements after the new length are zeroed.
+func RemoveFunc[T any](s []T, f func(T) bool) []T {
+	return slices.DeleteFunc(s, f)
+}
+
+// FilterInPlace is like Filter but reuses the backing array of s instead of allocating.
+// The elements after the new length are zeroed, so s must not be used afterwards.
+func FilterInPlace[T any](s []T, f func(T) bool) []T {
+	return slices.DeleteFunc(s, func(v T) bool { return !f(v) })
+}
@@ -0,0 +1,155 @@
+package sliceutils
+
+import (
+	"errors"
+	"slices"
+	"testing"
+)
+
+func TestRemoveIndex(t *testing.T) {
+	s := []int{0, 1, 2, 3}
+	r := RemoveIndex(s, 1)
+	if !slices.Equal(r, []int{0, 2, 3}) {
+		t.Fatalf("RemoveIndex = %v", r)
+	}
+	// The tail is shifted in place and the last slot keeps its old value.
+	if !slices.Equal(s, []int{0, 2, 3, 3}) {
+		t.Fatalf("backing array = %v", s)
+	}
+	if &r[0] != &s[0] {
+		t.Fatal("result does not share the backing array")
+	}
+}
+
+func TestRemoveIndexFast(t *testing.T) {
+	s := []int{0, 1, 2, 3}
+	r := RemoveIndexFast(s, 0)
+	if !slices.Equal(r, []int{3, 1, 2}) {
+		t.Fatalf("RemoveIndexFast = %v", r)
+	}
+	if !slices.Equal(s, []int{3, 1, 2, 3}) {
+		t.Fatalf("backing array = %v", s)
+	}
+}
+
+func TestRemoveIndexFastC(t *testing.T) {
+	s := []int{0, 1, 2, 3}
+	r := RemoveIndexFastC(s, 0)
+	if !slices.Equal(r, []int{3, 1, 2}) {
+		t.Fatalf("RemoveIndexFastC = %v", r)
+	}
+	if !slices.Equal(s, []int{0, 1, 2, 3}) {
+		t.Fatalf("input was modified: %v", s)
+	}
+}
+
+func TestRemoveIndexErr(t *testing.T) {
+	for _, f := range []struct {
+		name string
+		f    func([]int, int) ([]int, error)
+	}{
+		{"RemoveIndexErr", RemoveIndexErr[int]},
+		{"RemoveIndexFastErr", RemoveIndexFastErr[int]},
+	} {
+		for _, i := range []int{-1, 3} {
+			s := []int{0, 1, 2}
+			r, err := f.f(s, i)
+			if !errors.Is(err, ErrIndexOutOfRange) {
+				t.Errorf("%s(%d): err = %v", f.name, i, err)
+			}
+			if !slices.Equal(r, []int{0, 1, 2}) || !slices.Equal(s, []int{0, 1, 2}) {
+				t.Errorf("%s(%d): changed the slice: %v, %v", f.name, i, r, s)
+			}
+		}
+	}
+
+	s := []int{0, 1, 2}
+	if r, err := RemoveIndexErr(s, 0); err != nil || !slices.Equal(r, []int{1, 2}) || !slices.Equal(s, []int{1, 2, 2}) {
+		t.Errorf("RemoveIndexErr = %v, %v; backing array %v", r, err, s)
+	}
+	s = []int{0, 1, 2}
+	if r, err := RemoveIndexFastErr(s, 0); err != nil || !slices.Equal(r, []int{2, 1}) || !slices.Equal(s, []int{2, 1, 2}) {
+		t.Errorf("RemoveIndexFastErr = %v, %v; backing array %v", r, err, s)
+	}
+}
+
+func TestRemoveIndices(t *testing.T) {
+	s := []int{0, 1, 2, 3, 4, 5, 6}
+	r, err := RemoveIndices(s, 5, 1, 1, 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !slices.Equal(r, []int{0, 2, 4, 6}) {
+		t.Fatalf("RemoveIndices = %v", r)
+	}
+	// The slots after the new length are zeroed.
+	if !slices.Equal(s, []int{0, 2, 4, 6, 0, 0, 0}) {
+		t.Fatalf("backing array = %v", s)
+	}
+
+	s = []int{0, 1, 2, 3, 4}
+	if r, err := RemoveIndices(s, 0, 0, 2, 4); err != nil || !slices.Equal(r, []int{1, 3}) {
+		t.Fatalf("sorted indices: %v, %v", r, err)
+	}
+
+	s = []int{0, 1, 2}
+	if r, err := RemoveIndices(s); err != nil || !slices.Equal(r, s) {
+		t.Fatalf("no indices: %v, %v", r, err)
+	}
+
+	s = []int{0, 1, 2}
+	r, err = RemoveIndices(s, 0, 3)
+	if !errors.Is(err, ErrIndexOutOfRange) {
+		t.Fatalf("err = %v", err)
+	}
+	if !slices.Equal(r, []int{0, 1, 2}) || !slices.Equal(s, []int{0, 1, 2}) {
+		t.Fatalf("changed the slice on error: %v, %v", r, s)
+	}
+}
+
+func TestRemoveRange(t *testing.T) {
+	s := []int{0, 1, 2, 3, 4}
+	r, err := RemoveRange(s, 1, 3)
+	if err != nil || !slices.Equal(r, []int{0, 3, 4}) {
+		t.Fatalf("RemoveRange = %v, %v", r, err)
+	}
+	if !slices.Equal(s, []int{0, 3, 4, 0, 0}) {
+		t.Fatalf("backing array = %v", s)
+	}
+
+	for _, rng := range [][2]int{{-1, 1}, {2, 1}, {0, 6}} {
+		s := []int{0, 1, 2, 3, 4}
+		r, err := RemoveRange(s, rng[0], rng[1])
+		if !errors.Is(err, ErrIndexOutOfRange) {
+			t.Errorf("RemoveRange%v: err = %v", rng, err)
+		}
+		if !slices.Equal(r, []int{0, 1, 2, 3, 4}) || !slices.Equal(s, []int{0, 1, 2, 3, 4}) {
+			t.Errorf("RemoveRange%v: changed the slice: %v, %v", rng, r, s)
+		}
+	}
+}
+
+func TestRemoveFunc(t *testing.T) {
+	s := []int{0, 1, 2, 3, 4}
+	r := RemoveFunc(s, func(v int) bool { return v%2 == 0 })
+	if !slices.Equal(r, []int{1, 3}) {
+		t.Fatalf("RemoveFunc = %v", r)
+	}
+	if !slices.Equal(s, []int{1, 3, 0, 0, 0}) {
+		t.Fatalf("backing array = %v", s)
+	}
+}
+
+func TestFilterInPlace(t *testing.T) {
+	s := []int{0, 1, 2, 3, 4}
+	r := FilterInPlace(s, func(v int) bool { return v%2 == 0 })
+	if !slices.Equal(r, []int{0, 2, 4}) {
+		t.Fatalf("FilterInPlace = %v", r)
+	}
+	if !slices.Equal(s, []int{0, 2, 4, 0, 0}) {
+		t.Fatalf("backing array = %v", s)
+	}
+	if &r[0] != &s[0] {
+		t.Fatal("result does not share the backing array")
+	}
+}
@@ -0,0 +1,156 @@
+package sliceutils
+
+import (
+	"iter"
+	"slices"
+	"sync"
+)
+
+type RingPolicy uint8
+
+const (
+	// RingOverwrite makes Push on a full buffer overwrite the oldest element.
+	RingOverwrite RingPolicy = iota
+	// RingReject makes Push on a full buffer drop the new element.
+	RingReject
+)
+
+// RingBuffer is a FIFO buffer with a fixed capacity.
+// The zero value has no capacity and rejects every Push.
+type RingBuffer[T any] struct {
+	buf    []T
+	head   int // index of the oldest element
+	n      int
+	policy RingPolicy
+}
+
+// Push adds v as the newest element. It returns false if the buffer is full
+// and the policy is [RingReject].
+func (r *RingBuffer[T]) Push(v T) bool {
+	if len(r.buf) == 0 {
+		return false
+	}
+	if r.n == len(r.buf) {
+		if r.policy == RingReject {
+			return false
+		}
+		r.buf[r.head] = v
+		r.head = (r.head + 1) % len(r.buf)
+		return true
+	}
+	r.buf[(r.head+r.n)%len(r.buf)] = v
+	r.n++
+	return true
+}
+
+// Pop removes and returns the oldest element.
+func (r *RingBuffer[T]) Pop() (v T, ok bool) {
+	if r.n == 0 {
+		return v, false
+	}
+	v = r.buf[r.head]
+	var zero T
+	r.buf[r.head] = zero
+	r.head = (r.head + 1) % len(r.buf)
+	r.n--
+	return v, true
+}
+
+// At returns the i-th element counting from the oldest one.
+func (r *RingBuffer[T]) At(i int) (v T, ok bool) {
+	if i < 0 || i >= r.n {
+		return v, false
+	}
+	return r.buf[(r.head+i)%len(r.buf)], true
+}
+
+func (r *RingBuffer[T]) Oldest() (T, bool) { return r.At(0) }
+func (r *RingBuffer[T]) Newest() (T, bool) { return r.At(r.n - 1) }
+
+func (r *RingBuffer[T]) Len() int   { return r.n }
+func (r *RingBuffer[T]) Cap() int   { return len(r.buf) }
+func (r *RingBuffer[T]) Full() bool { return r.n == len(r.buf) }
+
+func (r *RingBuffer[T]) Clear() {
+	clear(r.buf)
+	r.head, r.n = 0, 0
+}
+
+// All iterates from the oldest to the newest element.
+// The buffer must not be modified during the iteration.
+func (r *RingBuffer[T]) All() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		for i := range r.n {
+			if !yield(i, r.buf[(r.head+i)%len(r.buf)]) {
+				return
+			}
+		}
+	}
+}
+
+// Slice returns a copy of the elements from the oldest to the newest.
+func (r *RingBuffer[T]) Slice() []T {
+	s := make([]T, r.n)
+	for i, v := range r.All() {
+		s[i] = v
+	}
+	return s
+}
+
+func NewRingBuffer[T any](capacity int, policy RingPolicy) *RingBuffer[T] {
+	return &RingBuffer[T]{buf: make([]T, max(capacity, 0)), policy: policy}
+}
+
+// SyncRingBuffer is a [RingBuffer] that is safe for concurrent use.
+// Like with RingBuffer, the zero value has no capacity, so use [NewSyncRingBuffer].
+type SyncRingBuffer[T any] struct {
+	mu sync.Mutex
+	r  RingBuffer[T]
+}
+
+func (t *SyncRingBuffer[T]) Push(v T) bool {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.r.Push(v)
+}
+
+func (t *SyncRingBuffer[T]) Pop() (T, bool) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.r.Pop()
+}
+
+func (t *SyncRingBuffer[T]) At(i int) (T, bool) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.r.At(i)
+}
+
+func (t *SyncRingBuffer[T]) Len() int {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.r.Len()
+}
+
+func (t *SyncRingBuffer[T]) Cap() int { return t.r.Cap() }
+
+func (t *SyncRingBuffer[T]) Clear() {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.r.Clear()
+}
+
+func (t *SyncRingBuffer[T]) Slice() []T {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.r.Slice()
+}
+
+// All iterates over a snapshot of the buffer.
+func (t *SyncRingBuffer[T]) All() iter.Seq2[int, T] {
+	return slices.All(t.Slice())
+}
+
+func NewSyncRingBuffer[T any](capacity int, policy RingPolicy) *SyncRingBuffer[T] {
+	return &SyncRingBuffer[T]{r: *NewRingBuffer[T](capacity, policy)}
+}
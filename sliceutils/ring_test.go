@@ -0,0 +1,44 @@
+package sliceutils
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestRingBufferPolicies(t *testing.T) {
+	r := NewRingBuffer[int](3, RingOverwrite)
+	for i := range 5 {
+		if !r.Push(i) {
+			t.Fatalf("overwrite: Push(%d) rejected", i)
+		}
+	}
+	if got := r.Slice(); !slices.Equal(got, []int{2, 3, 4}) {
+		t.Fatalf("overwrite: %v", got)
+	}
+
+	r = NewRingBuffer[int](3, RingReject)
+	for i := range 5 {
+		if ok := r.Push(i); ok != (i < 3) {
+			t.Fatalf("reject: Push(%d) = %v", i, ok)
+		}
+	}
+	if v, ok := r.Pop(); !ok || v != 0 {
+		t.Fatalf("Pop = %d, %v", v, ok)
+	}
+	if got := r.Slice(); !slices.Equal(got, []int{1, 2}) {
+		t.Fatalf("reject: %v", got)
+	}
+}
+
+func TestSyncContainersZeroValue(t *testing.T) {
+	var r SyncRingBuffer[int]
+	if r.Push(1) || r.Len() != 0 || r.Cap() != 0 {
+		t.Fatal("zero SyncRingBuffer accepted a value")
+	}
+	var d SyncDeque[int]
+	d.PushBack(1)
+	d.PushFront(0)
+	if got := d.Slice(); !slices.Equal(got, []int{0, 1}) {
+		t.Fatalf("zero SyncDeque: %v", got)
+	}
+}
@@ -0,0 +1,37 @@
+package sliceutils
+
+import (
+	"context"
+	"slices"
+	"testing"
+)
+
+func TestSeqPipeline(t *testing.T) {
+	seq := TakeSeq(SkipSeq(FilterSeq(MapSeq(slices.Values([]int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}),
+		func(v int) int { return v * 10 }),
+		func(v int) bool { return v%20 == 0 }), 1), 3)
+	if got := slices.Collect(seq); !slices.Equal(got, []int{40, 60, 80}) {
+		t.Fatalf("pipeline = %v", got)
+	}
+
+	var pairs []string
+	for a, b := range ZipSeq(slices.Values([]int{1, 2, 3}), slices.Values([]string{"a", "b"})) {
+		pairs = append(pairs, string(rune('0'+a))+b)
+	}
+	if !slices.Equal(pairs, []string{"1a", "2b"}) {
+		t.Fatalf("ZipSeq = %v", pairs)
+	}
+
+	chained := ChainSeq(slices.Values([]int{1}), slices.Values([]int{2, 3}))
+	for i, v := range EnumerateSeq(chained) {
+		if v != i+1 {
+			t.Fatalf("EnumerateSeq: %d at %d", v, i)
+		}
+	}
+
+	got := slices.Collect(FromChan(ToChan(context.Background(), TakeWhileSeq(slices.Values([]int{1, 2, 5, 1}),
+		func(v int) bool { return v < 3 }))))
+	if !slices.Equal(got, []int{1, 2}) {
+		t.Fatalf("chan round trip = %v", got)
+	}
+}
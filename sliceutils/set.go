@@ -0,0 +1,102 @@
+package sliceutils
+
+// The functions below treat slices as sets using a map of the elements.
+// They keep the order of the first occurrence and drop duplicates.
+// For sorted inputs, use the *Sorted variants in set_sorted.go, which do not allocate a map.
+
+func toSet[T comparable](s []T) map[T]struct{} {
+	m := make(map[T]struct{}, len(s))
+	for _, v := range s {
+		m[v] = struct{}{}
+	}
+	return m
+}
+
+func Unique[T comparable](s []T) []T {
+	return UniqueBy(s, func(v T) T { return v })
+}
+
+// UniqueBy keeps the first element for each key.
+func UniqueBy[T any, K comparable](s []T, f func(T) K) []T {
+	seen := make(map[K]struct{}, len(s))
+	r := make([]T, 0, len(s))
+	for _, v := range s {
+		k := f(v)
+		if _, ok := seen[k]; !ok {
+			seen[k] = struct{}{}
+			r = append(r, v)
+		}
+	}
+	return r
+}
+
+func Union[T comparable](a, b []T) []T {
+	seen := make(map[T]struct{}, len(a)+len(b))
+	r := make([]T, 0, len(a)+len(b))
+	for _, s := range [][]T{a, b} {
+		for _, v := range s {
+			if _, ok := seen[v]; !ok {
+				seen[v] = struct{}{}
+				r = append(r, v)
+			}
+		}
+	}
+	return r
+}
+
+// Intersect returns the elements of a that are in b.
+func Intersect[T comparable](a, b []T) []T {
+	inB := toSet(b)
+	return Unique(Filter(a, func(v T) bool {
+		_, ok := inB[v]
+		return ok
+	}))
+}
+
+// Difference returns the elements of a that are not in b.
+func Difference[T comparable](a, b []T) []T {
+	inB := toSet(b)
+	return Unique(Filter(a, func(v T) bool {
+		_, ok := inB[v]
+		return !ok
+	}))
+}
+
+// SymmetricDifference returns the elements of a that are not in b,
+// followed by the elements of b that are not in a.
+func SymmetricDifference[T comparable](a, b []T) []T {
+	return append(Difference(a, b), Difference(b, a)...)
+}
+
+// IsSubset reports whether every element of a is in b.
+func IsSubset[T comparable](a, b []T) bool {
+	return ContainsAll(b, a)
+}
+
+// ContainsAll reports whether s contains every element of vals.
+func ContainsAll[T comparable](s, vals []T) bool {
+	if len(vals) == 0 {
+		return true
+	}
+	in := toSet(s)
+	for _, v := range vals {
+		if _, ok := in[v]; !ok {
+			return false
+		}
+	}
+	return true
+}
+
+// ContainsAny reports whether s contains at least one element of vals.
+func ContainsAny[T comparable](s, vals []T) bool {
+	if len(s) == 0 || len(vals) == 0 {
+		return false
+	}
+	in := toSet(vals)
+	for _, v := range s {
+		if _, ok := in[v]; ok {
+			return true
+		}
+	}
+	return false
+}
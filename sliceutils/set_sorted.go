@@ -0,0 +1,122 @@
+package sliceutils
+
+import "cmp"
+
+// The *Sorted functions expect both inputs to be sorted in ascending order and
+// merge them in a single pass. The results are sorted and have no duplicates.
+
+// mergeSorted walks a and b in order and calls emit for each distinct value
+// with whether it is in a and whether it is in b.
+func mergeSorted[T cmp.Ordered](a, b []T, emit func(v T, inA, inB bool) bool) {
+	i, j := 0, 0
+	for i < len(a) || j < len(b) {
+		// cmp.Compare orders NaN before any other value and equal to itself,
+		// so NaN is handled like any other value.
+		var c int
+		switch {
+		case j >= len(b):
+			c = -1
+		case i >= len(a):
+			c = 1
+		default:
+			c = cmp.Compare(a[i], b[j])
+		}
+		inA, inB := c <= 0, c >= 0
+		var v T
+		if inA {
+			v = a[i]
+			i++
+		} else {
+			v = b[j]
+		}
+		if inB {
+			j++
+		}
+		for i < len(a) && cmp.Compare(a[i], v) == 0 {
+			i++
+		}
+		for j < len(b) && cmp.Compare(b[j], v) == 0 {
+			j++
+		}
+		if !emit(v, inA, inB) {
+			return
+		}
+	}
+}
+
+func UnionSorted[T cmp.Ordered](a, b []T) []T {
+	r := make([]T, 0, max(len(a), len(b)))
+	mergeSorted(a, b, func(v T, _, _ bool) bool {
+		r = append(r, v)
+		return true
+	})
+	return r
+}
+
+func IntersectSorted[T cmp.Ordered](a, b []T) []T {
+	r := []T{}
+	mergeSorted(a, b, func(v T, inA, inB bool) bool {
+		if inA && inB {
+			r = append(r, v)
+		}
+		return true
+	})
+	return r
+}
+
+func DifferenceSorted[T cmp.Ordered](a, b []T) []T {
+	r := []T{}
+	mergeSorted(a, b, func(v T, inA, inB bool) bool {
+		if inA && !inB {
+			r = append(r, v)
+		}
+		return true
+	})
+	return r
+}
+
+func SymmetricDifferenceSorted[T cmp.Ordered](a, b []T) []T {
+	r := []T{}
+	mergeSorted(a, b, func(v T, inA, inB bool) bool {
+		if inA != inB {
+			r = append(r, v)
+		}
+		return true
+	})
+	return r
+}
+
+// IsSubsetSorted reports whether every element of a is in b.
+func IsSubsetSorted[T cmp.Ordered](a, b []T) bool {
+	ok := true
+	mergeSorted(a, b, func(_ T, inA, inB bool) bool {
+		ok = !inA || inB
+		return ok
+	})
+	return ok
+}
+
+// UniqueSorted drops adjacent duplicates from a sorted slice into a new slice.
+func UniqueSorted[T cmp.Ordered](s []T) []T {
+	r := []T{}
+	mergeSorted(s, nil, func(v T, _, _ bool) bool {
+		r = append(r, v)
+		return true
+	})
+	return r
+}
+
+// ContainsAllSorted reports whether s contains every element of vals.
+func ContainsAllSorted[T cmp.Ordered](s, vals []T) bool {
+	return IsSubsetSorted(vals, s)
+}
+
+// ContainsAnySorted reports whether s contains at least one element of vals.
+func ContainsAnySorted[T cmp.Ordered](s, vals []T) bool {
+	found := false
+	mergeSorted(s, vals, func(_ T, inS, inVals bool) bool {
+		found = inS && inVals
+		return !found
+	})
+	return found
+}
@@ -0,0 +1,54 @@
+package sliceutils
+
+import (
+	"math"
+	"slices"
+	"testing"
+)
+
+func TestMergeSortedNaN(t *testing.T) {
+	nan := math.NaN()
+	r := UnionSorted([]float64{nan, nan, 1, 2}, []float64{nan, 2, 3})
+	if len(r) != 4 || !math.IsNaN(r[0]) || !slices.Equal(r[1:], []float64{1, 2, 3}) {
+		t.Fatalf("UnionSorted = %v", r)
+	}
+	if r := IntersectSorted([]float64{nan}, nil); len(r) != 0 {
+		t.Fatalf("IntersectSorted = %v", r)
+	}
+}
+
+func TestSetSorted(t *testing.T) {
+	a := []int{1, 2, 2, 3, 5}
+	b := []int{2, 3, 4, 4, 6}
+	tests := []struct {
+		name string
+		got  []int
+		want []int
+	}{
+		{"union", UnionSorted(a, b), []int{1, 2, 3, 4, 5, 6}},
+		{"intersect", IntersectSorted(a, b), []int{2, 3}},
+		{"difference", DifferenceSorted(a, b), []int{1, 5}},
+		{"symmetric", SymmetricDifferenceSorted(a, b), []int{1, 4, 5, 6}},
+	}
+	for _, tt := range tests {
+		if !slices.Equal(tt.got, tt.want) {
+			t.Errorf("%s = %v, want %v", tt.name, tt.got, tt.want)
+		}
+	}
+	if !IsSubsetSorted([]int{2, 3}, b) || IsSubsetSorted([]int{1}, b) {
+		t.Error("IsSubsetSorted")
+	}
+}
+
+func TestUniqueContainsSorted(t *testing.T) {
+	if r := UniqueSorted([]int{1, 1, 2, 3, 3, 3}); !slices.Equal(r, []int{1, 2, 3}) {
+		t.Errorf("UniqueSorted = %v", r)
+	}
+	s := []int{1, 3, 5, 7}
+	if !ContainsAllSorted(s, []int{3, 7}) || ContainsAllSorted(s, []int{3, 4}) {
+		t.Error("ContainsAllSorted")
+	}
+	if !ContainsAnySorted(s, []int{2, 5}) || ContainsAnySorted(s, []int{2, 4}) || ContainsAnySorted(s, nil) {
+		t.Error("ContainsAnySorted")
+	}
+}
@@ -0,0 +1,113 @@
+package sliceutils
+
+import (
+	"cmp"
+	"slices"
+)
+
+// SortBy sorts s in place by the key, keeping the order of equal elements.
+// The key is computed once per element, so it can be expensive.
+func SortBy[T any, K cmp.Ordered](s []T, key func(T) K) {
+	type keyed struct {
+		k K
+		v T
+	}
+	ks := make([]keyed, len(s))
+	for i, v := range s {
+		ks[i] = keyed{key(v), v}
+	}
+	slices.SortStableFunc(ks, func(a, b keyed) int {
+		return cmp.Compare(a.k, b.k)
+	})
+	for i, v := range ks {
+		s[i] = v.v
+	}
+}
+
+// Asc returns a comparator that orders by the key in ascending order.
+func Asc[T any, K cmp.Ordered](key func(T) K) func(a, b T) int {
+	return func(a, b T) int {
+		return cmp.Compare(key(a), key(b))
+	}
+}
+
+// Desc returns a comparator that orders by the key in descending order.
+func Desc[T any, K cmp.Ordered](key func(T) K) func(a, b T) int {
+	return func(a, b T) int {
+		return cmp.Compare(key(b), key(a))
+	}
+}
+
+// ChainCmp returns a comparator that tries each comparator in turn until one of
+// them returns a non-zero result.
+func ChainCmp[T any](cmps ...func(a, b T) int) func(a, b T) int {
+	return func(a, b T) int {
+		for _, c := range cmps {
+			if r := c(a, b); r != 0 {
+				return r
+			}
+		}
+		return 0
+	}
+}
+
+// SortByMulti sorts s in place by several comparators, keeping the order of equal elements.
+//
+//	sliceutils.SortByMulti(users,
+//		sliceutils.Asc(func(u User) string { return u.Country }),
+//		sliceutils.Desc(func(u User) int { return u.Score }),
+//	)
+func SortByMulti[T any](s []T, cmps ...func(a, b T) int) {
+	slices.SortStableFunc(s, ChainCmp(cmps...))
+}
+
+// TopK returns the k largest elements by cmp in descending order.
+// It uses a heap of k elements, so it runs in O(n log k) and does not modify s.
+// Which of several equal elements make the cut, and their order, is not defined.
+func TopK[T any](s []T, k int, cmp func(a, b T) int) []T {
+	if k <= 0 {
+		return []T{}
+	}
+	h := &minHeap[T]{s: make([]T, 0, min(k, len(s))), cmp: cmp}
+	for _, v := range s {
+		if h.Len() < k {
+			h.Push(v)
+		} else if cmp(v, h.Top()) > 0 {
+			h.Replace(v)
+		}
+	}
+	r := make([]T, h.Len())
+	for i := len(r) - 1; i >= 0; i-- {
+		r[i] = h.Pop()
+	}
+	return r
+}
+
+// BottomK returns the k smallest elements by cmp in ascending order.
+func BottomK[T any](s []T, k int, cmp func(a, b T) int) []T {
+	return TopK(s, k, func(a, b T) int { return cmp(b, a) })
+}
+
+// MinBy returns the first element with the smallest key. It returns false if s is empty.
+func MinBy[T any, K cmp.Ordered](s []T, key func(T) K) (T, bool) {
+	return extremeBy(s, key, -1)
+}
+
+// MaxBy returns the first element with the largest key. It returns false if s is empty.
+func MaxBy[T any, K cmp.Ordered](s []T, key func(T) K) (T, bool) {
+	return extremeBy(s, key, 1)
+}
+
+func extremeBy[T any, K cmp.Ordered](s []T, key func(T) K, sign int) (r T, ok bool) {
+	if len(s) == 0 {
+		return r, false
+	}
+	r = s[0]
+	best := key(r)
+	for _, v := range s[1:] {
+		if k := key(v); cmp.Compare(k, best) == sign {
+			r, best = v, k
+		}
+	}
+	return r, true
+}
@@ -0,0 +1,128 @@
+package sliceutils
+
+import (
+	"cmp"
+	"math/rand/v2"
+	"slices"
+	"testing"
+)
+
+type sortItem struct {
+	key  int
+	name string
+}
+
+func itemKey(v sortItem) int { return v.key }
+
+func TestSortByStable(t *testing.T) {
+	s := []sortItem{{2, "a"}, {1, "b"}, {2, "c"}, {1, "d"}, {0, "e"}}
+	calls := 0
+	SortBy(s, func(v sortItem) int {
+		calls++
+		return v.key
+	})
+	want := []sortItem{{0, "e"}, {1, "b"}, {1, "d"}, {2, "a"}, {2, "c"}}
+	if !slices.Equal(s, want) {
+		t.Fatalf("SortBy = %v", s)
+	}
+	if calls != len(s) {
+		t.Fatalf("key called %d times, want once per element", calls)
+	}
+}
+
+func TestSortByMulti(t *testing.T) {
+	s := []sortItem{{1, "b"}, {2, "a"}, {1, "a"}, {2, "b"}, {1, "b"}}
+	SortByMulti(s, Desc(itemKey), Asc(func(v sortItem) string { return v.name }))
+	want := []sortItem{{2, "a"}, {2, "b"}, {1, "a"}, {1, "b"}, {1, "b"}}
+	if !slices.Equal(s, want) {
+		t.Fatalf("SortByMulti = %v", s)
+	}
+
+	// Elements equal by every comparator keep their order.
+	s = []sortItem{{1, "x"}, {0, "y"}, {1, "z"}}
+	SortByMulti(s, Asc(itemKey))
+	if !slices.Equal(s, []sortItem{{0, "y"}, {1, "x"}, {1, "z"}}) {
+		t.Fatalf("SortByMulti is not stable: %v", s)
+	}
+	SortByMulti(s)
+	if !slices.Equal(s, []sortItem{{0, "y"}, {1, "x"}, {1, "z"}}) {
+		t.Fatalf("SortByMulti without comparators changed the order: %v", s)
+	}
+}
+
+func TestTopK(t *testing.T) {
+	s := []int{5, 1, 9, 3, 7, 9, 2}
+	orig := slices.Clone(s)
+	for _, tt := range []struct {
+		k           int
+		top, bottom []int
+	}{
+		{3, []int{9, 9, 7}, []int{1, 2, 3}},
+		{1, []int{9}, []int{1}},
+		{7, []int{9, 9, 7, 5, 3, 2, 1}, []int{1, 2, 3, 5, 7, 9, 9}},
+		{100, []int{9, 9, 7, 5, 3, 2, 1}, []int{1, 2, 3, 5, 7, 9, 9}},
+		{0, []int{}, []int{}},
+		{-1, []int{}, []int{}},
+	} {
+		if got := TopK(s, tt.k, cmp.Compare[int]); !slices.Equal(got, tt.top) {
+			t.Fatalf("TopK(%d) = %v, want %v", tt.k, got, tt.top)
+		}
+		if got := BottomK(s, tt.k, cmp.Compare[int]); !slices.Equal(got, tt.bottom) {
+			t.Fatalf("BottomK(%d) = %v, want %v", tt.k, got, tt.bottom)
+		}
+	}
+	if !slices.Equal(s, orig) {
+		t.Fatalf("TopK modified s: %v", s)
+	}
+	if got := TopK([]int(nil), 3, cmp.Compare[int]); len(got) != 0 {
+		t.Fatalf("TopK(nil) = %v", got)
+	}
+
+	// With ties the keys of the result are still the k largest.
+	items := []sortItem{{3, "a"}, {3, "b"}, {5, "c"}, {3, "d"}, {1, "e"}}
+	top := TopK(items, 3, Asc(itemKey))
+	if keys := Map(top, itemKey); !slices.Equal(keys, []int{5, 3, 3}) {
+		t.Fatalf("TopK with ties = %v", top)
+	}
+}
+
+func TestMinByMaxBy(t *testing.T) {
+	s := []sortItem{{2, "a"}, {1, "b"}, {3, "c"}, {1, "d"}, {3, "e"}}
+	if v, ok := MinBy(s, itemKey); !ok || v != (sortItem{1, "b"}) {
+		t.Fatalf("MinBy = %v, %v", v, ok)
+	}
+	if v, ok := MaxBy(s, itemKey); !ok || v != (sortItem{3, "c"}) {
+		t.Fatalf("MaxBy = %v, %v", v, ok)
+	}
+	if _, ok := MinBy([]sortItem{}, itemKey); ok {
+		t.Fatal("MinBy on an empty slice returned true")
+	}
+	if _, ok := MaxBy([]sortItem(nil), itemKey); ok {
+		t.Fatal("MaxBy on nil returned true")
+	}
+}
+
+func TestMinHeap(t *testing.T) {
+	r := rand.New(rand.NewPCG(1, 2))
+	h := &minHeap[int]{cmp: cmp.Compare[int]}
+	var want []int
+	for range 200 {
+		v := r.IntN(50)
+		h.Push(v)
+		want = append(want, v)
+	}
+	slices.Sort(want)
+	// Replace the top with values larger than every element, and check they come out last.
+	for i := range 10 {
+		want[i] = 100 + i
+		h.Replace(100 + i)
+	}
+	slices.Sort(want)
+	var got []int
+	for h.Len() > 0 {
+		got = append(got, h.Pop())
+	}
+	if !slices.Equal(got, want) {
+		t.Fatalf("heap order = %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,125 @@
+package sliceutils
+
+import (
+	"iter"
+	"slices"
+)
+
+// Sorted is a slice kept sorted by a comparator. Equal elements are kept
+// in insertion order.
+// The zero value has no comparator and panics on use; use [NewSorted].
+type Sorted[T any] struct {
+	s   []T
+	cmp func(a, b T) int
+}
+
+// LowerBound returns the index of the first element that is not less than v.
+func (t *Sorted[T]) LowerBound(v T) int {
+	i, _ := slices.BinarySearchFunc(t.s, v, t.cmp)
+	return i
+}
+
+// UpperBound returns the index of the first element that is greater than v.
+func (t *Sorted[T]) UpperBound(v T) int {
+	lo, hi := t.LowerBound(v), len(t.s)
+	for lo < hi {
+		m := int(uint(lo+hi) >> 1)
+		if t.cmp(t.s[m], v) <= 0 {
+			lo = m + 1
+		} else {
+			hi = m
+		}
+	}
+	return lo
+}
+
+// Rank returns the number of elements less than v.
+func (t *Sorted[T]) Rank(v T) int {
+	return t.LowerBound(v)
+}
+
+// Insert adds v after the elements equal to it and returns its index.
+func (t *Sorted[T]) Insert(v T) int {
+	i := t.UpperBound(v)
+	t.s = slices.Insert(t.s, i, v)
+	return i
+}
+
+// Remove removes one element equal to v. It returns false if there is none.
+func (t *Sorted[T]) Remove(v T) bool {
+	i, ok := slices.BinarySearchFunc(t.s, v, t.cmp)
+	if ok {
+		t.s = slices.Delete(t.s, i, i+1)
+	}
+	return ok
+}
+
+func (t *Sorted[T]) Contains(v T) bool {
+	_, ok := slices.BinarySearchFunc(t.s, v, t.cmp)
+	return ok
+}
+
+// Range returns the elements in [lo, hi). The result shares the backing array
+// and is valid until the next change.
+func (t *Sorted[T]) Range(lo, hi T) []T {
+	i, j := t.LowerBound(lo), t.LowerBound(hi)
+	if j < i {
+		return []T{}
+	}
+	return t.s[i:j:j]
+}
+
+func (t *Sorted[T]) At(i int) (v T, ok bool) {
+	if i < 0 || i >= len(t.s) {
+		return v, false
+	}
+	return t.s[i], true
+}
+
+func (t *Sorted[T]) Len() int { return len(t.s) }
+
+// Slice returns a copy of the elements.
+func (t *Sorted[T]) Slice() []T { return slices.Clone(t.s) }
+
+func (t *Sorted[T]) All() iter.Seq2[int, T] { return slices.All(t.s) }
+
+// NewSorted returns a [Sorted] ordered by cmp with the given elements.
+func NewSorted[T any](cmp func(a, b T) int, s ...T) *Sorted[T] {
+	s = slices.Clone(s)
+	slices.SortStableFunc(s, cmp)
+	return &Sorted[T]{s: s, cmp: cmp}
+}
+
+// MergeSorted merges slices sorted by cmp into one sorted slice using a heap,
+// in O(n log k) for k slices. Equal elements keep the order of the input slices.
+func MergeSorted[T any](cmp func(a, b T) int, ss ...[]T) []T {
+	type cursor struct {
+		s   []T
+		src int
+	}
+	n := 0
+	h := &minHeap[cursor]{cmp: func(a, b cursor) int {
+		if c := cmp(a.s[0], b.s[0]); c != 0 {
+			return c
+		}
+		return a.src - b.src
+	}}
+	for i, s := range ss {
+		if len(s) > 0 {
+			h.Push(cursor{s, i})
+			n += len(s)
+		}
+	}
+
+	r := make([]T, 0, n)
+	for h.Len() > 0 {
+		c := h.Top()
+		r = append(r, c.s[0])
+		if c.s = c.s[1:]; len(c.s) > 0 {
+			h.Replace(c)
+		} else {
+			h.Pop()
+		}
+	}
+	return r
+}
@@ -0,0 +1,108 @@
+package sliceutils
+
+import (
+	"cmp"
+	"slices"
+	"testing"
+)
+
+func TestSortedInsertAfterEqual(t *testing.T) {
+	byKey := func(a, b sortItem) int { return cmp.Compare(a.key, b.key) }
+	s := NewSorted(byKey, sortItem{2, "a"}, sortItem{1, "b"}, sortItem{2, "c"})
+	if i := s.Insert(sortItem{2, "d"}); i != 3 {
+		t.Fatalf("Insert = %d, want after the equal elements", i)
+	}
+	if i := s.Insert(sortItem{1, "e"}); i != 1 {
+		t.Fatalf("Insert = %d", i)
+	}
+	want := []sortItem{{1, "b"}, {1, "e"}, {2, "a"}, {2, "c"}, {2, "d"}}
+	if got := s.Slice(); !slices.Equal(got, want) {
+		t.Fatalf("Slice = %v", got)
+	}
+}
+
+func TestSortedBounds(t *testing.T) {
+	s := NewSorted(cmp.Compare[int], 5, 1, 3, 3, 3, 7)
+	for _, tt := range []struct{ v, lower, upper int }{
+		{0, 0, 0},
+		{1, 0, 1},
+		{3, 1, 4},
+		{4, 4, 4},
+		{5, 4, 5},
+		{7, 5, 6},
+		{8, 6, 6},
+	} {
+		if got := s.LowerBound(tt.v); got != tt.lower {
+			t.Fatalf("LowerBound(%d) = %d, want %d", tt.v, got, tt.lower)
+		}
+		if got := s.UpperBound(tt.v); got != tt.upper {
+			t.Fatalf("UpperBound(%d) = %d, want %d", tt.v, got, tt.upper)
+		}
+		if got := s.Rank(tt.v); got != tt.lower {
+			t.Fatalf("Rank(%d) = %d, want %d", tt.v, got, tt.lower)
+		}
+	}
+}
+
+func TestSortedRemove(t *testing.T) {
+	s := NewSorted(cmp.Compare[int], 1, 2, 2, 3)
+	if s.Remove(4) || s.Remove(0) {
+		t.Fatal("Remove of a missing value returned true")
+	}
+	if !s.Remove(2) || !s.Contains(2) || s.Len() != 3 {
+		t.Fatalf("Remove(2) = %v", s.Slice())
+	}
+	if !s.Remove(2) || s.Contains(2) || s.Remove(2) {
+		t.Fatalf("second Remove(2) = %v", s.Slice())
+	}
+	if got := s.Slice(); !slices.Equal(got, []int{1, 3}) {
+		t.Fatalf("Slice = %v", got)
+	}
+	if v, ok := s.At(1); !ok || v != 3 {
+		t.Fatalf("At(1) = %d, %v", v, ok)
+	}
+	if _, ok := s.At(2); ok {
+		t.Fatal("At out of range returned true")
+	}
+}
+
+func TestSortedRange(t *testing.T) {
+	s := NewSorted(cmp.Compare[int], 1, 2, 2, 3, 5)
+	if got := s.Range(2, 5); !slices.Equal(got, []int{2, 2, 3}) {
+		t.Fatalf("Range(2, 5) = %v", got)
+	}
+	if got := s.Range(4, 4); len(got) != 0 {
+		t.Fatalf("Range(4, 4) = %v", got)
+	}
+	if got := s.Range(5, 1); got == nil || len(got) != 0 {
+		t.Fatalf("Range(5, 1) = %#v, want an empty slice", got)
+	}
+	// The result has no spare capacity, so appending to it does not touch s.
+	r := s.Range(1, 3)
+	_ = append(r, 100)
+	if got := s.Slice(); !slices.Equal(got, []int{1, 2, 2, 3, 5}) {
+		t.Fatalf("append to Range changed s: %v", got)
+	}
+}
+
+func TestMergeSorted(t *testing.T) {
+	byKey := func(a, b sortItem) int { return cmp.Compare(a.key, b.key) }
+	got := MergeSorted(byKey,
+		[]sortItem{{1, "a0"}, {2, "a1"}},
+		nil,
+		[]sortItem{{1, "c0"}, {1, "c1"}, {3, "c2"}},
+		[]sortItem{},
+		[]sortItem{{0, "e0"}, {2, "e1"}},
+	)
+	want := []sortItem{{0, "e0"}, {1, "a0"}, {1, "c0"}, {1, "c1"}, {2, "a1"}, {2, "e1"}, {3, "c2"}}
+	if !slices.Equal(got, want) {
+		t.Fatalf("MergeSorted = %v", got)
+	}
+
+	if got := MergeSorted(cmp.Compare[int]); got == nil || len(got) != 0 {
+		t.Fatalf("MergeSorted() = %#v", got)
+	}
+	if got := MergeSorted(cmp.Compare[int], nil, []int{}); len(got) != 0 {
+		t.Fatalf("MergeSorted of empty slices = %v", got)
+	}
+}
@@ -0,0 +1,156 @@
+package sliceutils
+
+import (
+	"math"
+	"slices"
+	"sort"
+)
+
+// Number is a constraint for the numeric types, including named ones like time.Duration.
+type Number interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 |
+		~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr |
+		~float32 | ~float64
+}
+
+func Sum[T Number](s []T) T {
+	var r T
+	for _, v := range s {
+		r += v
+	}
+	return r
+}
+
+// Mean returns the arithmetic mean. It returns false if s is empty.
+func Mean[T Number](s []T) (float64, bool) {
+	if len(s) == 0 {
+		return 0, false
+	}
+	var mean float64
+	for i, v := range s {
+		mean += (float64(v) - mean) / float64(i+1)
+	}
+	return mean, true
+}
+
+// Variance returns the population variance. It returns false if s is empty.
+func Variance[T Number](s []T) (float64, bool) {
+	if len(s) == 0 {
+		return 0, false
+	}
+	// Welford's algorithm.
+	var mean, m2 float64
+	for i, v := range s {
+		x := float64(v)
+		d := x - mean
+		mean += d / float64(i+1)
+		m2 += d * (x - mean)
+	}
+	return m2 / float64(len(s)), true
+}
+
+// StdDev returns the population standard deviation. It returns false if s is empty.
+func StdDev[T Number](s []T) (float64, bool) {
+	v, ok := Variance(s)
+	return math.Sqrt(v), ok
+}
+
+func MinMax[T Number](s []T) (lo, hi T, ok bool) {
+	if len(s) == 0 {
+		return lo, hi, false
+	}
+	lo, hi = s[0], s[0]
+	for _, v := range s[1:] {
+		lo = min(lo, v)
+		hi = max(hi, v)
+	}
+	return lo, hi, true
+}
+
+type PercentileMethod uint8
+
+// The methods match the ones of numpy.percentile. For a rank between
+// two elements, they return:
+const (
+	PercentileLinear   PercentileMethod = iota // the linear interpolation between them
+	PercentileLower                            // the lower one
+	PercentileHigher                           // the higher one
+	PercentileNearest                          // the nearest one, or the even-indexed one for a tie
+	PercentileMidpoint                         // the mean of them
+)
+
+// Median returns the 50th percentile with linear interpolation.
+// It returns false if s is empty.
+func Median[T Number](s []T) (float64, bool) {
+	return Percentile(s, 50, PercentileLinear)
+}
+
+// Percentile returns the p-th percentile, with p in [0, 100]; p outside of
+// the range is clamped. It does not modify s. It returns false if s is empty or p is NaN.
+func Percentile[T Number](s []T, p float64, method PercentileMethod) (float64, bool) {
+	if math.IsNaN(p) {
+		return 0, false
+	}
+	r := Percentiles(s, method, p)
+	if r == nil {
+		return 0, false
+	}
+	return r[0], true
+}
+
+// Percentiles is like Percentile for many p but sorts s only once.
+// It returns nil if s is empty. The result is NaN for each p that is NaN.
+func Percentiles[T Number](s []T, method PercentileMethod, ps ...float64) []float64 {
+	if len(s) == 0 {
+		return nil
+	}
+	sorted := slices.Clone(s)
+	slices.Sort(sorted)
+	r := make([]float64, len(ps))
+	for i, p := range ps {
+		r[i] = percentileSorted(sorted, p, method)
+	}
+	return r
+}
+
+func percentileSorted[T Number](s []T, p float64, method PercentileMethod) float64 {
+	if math.IsNaN(p) {
+		return math.NaN()
+	}
+	rank := min(max(p, 0), 100) / 100 * float64(len(s)-1)
+	lo := int(math.Floor(rank))
+	hi := min(lo+1, len(s)-1)
+	frac := rank - float64(lo)
+	a, b := float64(s[lo]), float64(s[hi])
+	switch method {
+	case PercentileLower:
+		return a
+	case PercentileHigher:
+		if frac == 0 {
+			return a
+		}
+		return b
+	case PercentileNearest:
+		if frac < 0.5 || (frac == 0.5 && lo%2 == 0) {
+			return a
+		}
+		return b
+	case PercentileMidpoint:
+		if frac == 0 {
+			return a
+		}
+		return (a + b) / 2
+	}
+	return a + (b-a)*frac
+}
+
+// Histogram counts the values into buckets given by their sorted upper bounds.
+// The i-th count is the number of values in (bounds[i-1], bounds[i]], and
+// the extra last count is the number of values greater than the last bound.
+func Histogram[T Number](s []T, bounds []T) []int {
+	counts := make([]int, len(bounds)+1)
+	for _, v := range s {
+		counts[sort.Search(len(bounds), func(i int) bool { return v <= bounds[i] })]++
+	}
+	return counts
+}
@@ -0,0 +1,42 @@
+package sliceutils
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+func TestPercentile(t *testing.T) {
+	s := []time.Duration{40, 10, 30, 20}
+	tests := []struct {
+		method PercentileMethod
+		want   float64
+	}{
+		{PercentileLinear, 25},
+		{PercentileLower, 20},
+		{PercentileHigher, 30},
+		{PercentileNearest, 30},
+		{PercentileMidpoint, 25},
+	}
+	for _, tt := range tests {
+		if got, ok := Percentile(s, 50, tt.method); !ok || got != tt.want {
+			t.Errorf("method %d: got %v, want %v", tt.method, got, tt.want)
+		}
+	}
+	if got, _ := Percentile(s, 150, PercentileLinear); got != 40 {
+		t.Errorf("p > 100: got %v", got)
+	}
+	if _, ok := Percentile([]int{}, 50, PercentileLinear); ok {
+		t.Error("empty slice: ok")
+	}
+}
+
+func TestPercentileNaN(t *testing.T) {
+	if v, ok := Percentile([]int{1, 2, 3}, math.NaN(), PercentileLinear); ok || v != 0 {
+		t.Fatalf("Percentile(NaN) = %v, %v", v, ok)
+	}
+	r := Percentiles([]int{1, 2, 3}, PercentileLinear, 50, math.NaN())
+	if r[0] != 2 || !math.IsNaN(r[1]) {
+		t.Fatalf("Percentiles = %v", r)
+	}
+}
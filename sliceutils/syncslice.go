@@ -0,0 +1,192 @@
+package sliceutils
+
+import (
+	"cmp"
+	"iter"
+	"math/rand/v2"
+	"runtime"
+	"slices"
+	"sync"
+	"sync/atomic"
+)
+
+// SyncSlice is a slice that is safe for concurrent use.
+type SyncSlice[T any] struct {
+	mu sync.RWMutex
+	s  []T
+
+	// Append-heavy mode: appends go to a random shard and are merged into s
+	// in the order of their sequence numbers before the next read.
+	shards []appendShard[T]
+	_      [64]byte // keep seq off the cache line of mu and s
+	seq    atomic.Uint64
+}
+
+type stampedItem[T any] struct {
+	seq uint64
+	v   T
+}
+
+type appendShard[T any] struct {
+	mu      sync.Mutex
+	items   []stampedItem[T]
+	pending atomic.Int64
+	_       [64]byte // avoid false sharing between shards
+}
+
+type SyncSliceOpt[T any] func(*SyncSlice[T])
+
+// WithAppendShards enables the append-heavy mode with n shards (GOMAXPROCS if n <= 0).
+// Each append locks one randomly chosen shard, so concurrent appends do not
+// contend on a lock; the only state they share is an atomic sequence counter.
+// The shards are merged into the slice on the next read, ordered by the sequence
+// number taken by Append. So appends by one goroutine keep their order, while
+// concurrent appends have no defined order.
+func WithAppendShards[T any](n int) SyncSliceOpt[T] {
+	return func(t *SyncSlice[T]) {
+		if n <= 0 {
+			n = runtime.GOMAXPROCS(0)
+		}
+		t.shards = make([]appendShard[T], n)
+	}
+}
+
+func (t *SyncSlice[T]) Append(v ...T) {
+	if len(v) == 0 {
+		return
+	}
+	if t.shards == nil {
+		t.mu.Lock()
+		defer t.mu.Unlock()
+		t.s = append(t.s, v...)
+		return
+	}
+
+	// rand.Uint32 uses a per-thread generator, so picking a shard is contention free.
+	shard := &t.shards[rand.Uint32()%uint32(len(t.shards))]
+	shard.mu.Lock()
+	// Taking the sequence numbers under the shard lock means a merge, which
+	// holds every shard lock, never sees a number whose item is missing.
+	seq := t.seq.Add(uint64(len(v))) - uint64(len(v))
+	for i, val := range v {
+		shard.items = append(shard.items, stampedItem[T]{seq + uint64(i), val})
+	}
+	shard.pending.Add(int64(len(v)))
+	shard.mu.Unlock()
+}
+
+func (t *SyncSlice[T]) hasPending() bool {
+	for i := range t.shards {
+		if t.shards[i].pending.Load() > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// merge moves the pending appends from the shards into the slice.
+func (t *SyncSlice[T]) merge() {
+	if !t.hasPending() {
+		return
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	// Lock every shard before draining any of them. Otherwise an Append could
+	// land in an already drained shard while a later shard still holds an
+	// earlier append of the same goroutine, and the two would end up reversed.
+	for i := range t.shards {
+		t.shards[i].mu.Lock()
+	}
+	var items []stampedItem[T]
+	for i := range t.shards {
+		shard := &t.shards[i]
+		items = append(items, shard.items...)
+		clear(shard.items)
+		shard.items = shard.items[:0]
+		shard.pending.Store(0)
+	}
+	for i := range t.shards {
+		t.shards[i].mu.Unlock()
+	}
+	slices.SortFunc(items, func(a, b stampedItem[T]) int {
+		return cmp.Compare(a.seq, b.seq)
+	})
+	for _, item := range items {
+		t.s = append(t.s, item.v)
+	}
+}
+
+func (t *SyncSlice[T]) Get(i int) (v T, ok bool) {
+	t.merge()
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	if i < 0 || i >= len(t.s) {
+		return v, false
+	}
+	return t.s[i], true
+}
+
+func (t *SyncSlice[T]) Set(i int, v T) error {
+	t.merge()
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if err := checkIndex(i, len(t.s)); err != nil {
+		return err
+	}
+	t.s[i] = v
+	return nil
+}
+
+// RemoveIndex removes the element at i keeping the order, like [RemoveIndex].
+func (t *SyncSlice[T]) RemoveIndex(i int) error {
+	return t.remove(i, RemoveIndexErr[T])
+}
+
+// RemoveIndexFast removes the element at i without keeping the order, like [RemoveIndexFast].
+func (t *SyncSlice[T]) RemoveIndexFast(i int) error {
+	return t.remove(i, RemoveIndexFastErr[T])
+}
+
+func (t *SyncSlice[T]) remove(i int, f func([]T, int) ([]T, error)) error {
+	t.merge()
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	n := len(t.s)
+	s, err := f(t.s, i)
+	if err != nil {
+		return err
+	}
+	// Release the reference held by the freed slot.
+	clear(t.s[len(s):n])
+	t.s = s
+	return nil
+}
+
+func (t *SyncSlice[T]) Len() int {
+	t.merge()
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	return len(t.s)
+}
+
+// Snapshot returns a copy of the slice.
+func (t *SyncSlice[T]) Snapshot() []T {
+	t.merge()
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	return slices.Clone(t.s)
+}
+
+// All iterates over a snapshot of the slice.
+func (t *SyncSlice[T]) All() iter.Seq2[int, T] {
+	return slices.All(t.Snapshot())
+}
+
+func NewSyncSlice[T any](opts ...SyncSliceOpt[T]) *SyncSlice[T] {
+	t := &SyncSlice[T]{}
+	for _, opt := range opts {
+		opt(t)
+	}
+	return t
+}
@@ -0,0 +1,107 @@
+package sliceutils
+
+import (
+	"sync"
+	"testing"
+)
+
+func TestSyncSlice(t *testing.T) {
+	for name, s := range map[string]*SyncSlice[int]{
+		"mutex":   NewSyncSlice[int](),
+		"sharded": NewSyncSlice(WithAppendShards[int](4)),
+	} {
+		t.Run(name, func(t *testing.T) {
+			for i := range 100 {
+				s.Append(i)
+			}
+			var wg sync.WaitGroup
+			for range 8 {
+				wg.Add(1)
+				go func() {
+					defer wg.Done()
+					for i := range 1000 {
+						s.Append(i, i)
+						if i%100 == 0 {
+							s.Len()
+						}
+					}
+				}()
+			}
+			wg.Wait()
+
+			snap := s.Snapshot()
+			if len(snap) != 100+8*2000 || s.Len() != len(snap) {
+				t.Fatalf("len = %d", len(snap))
+			}
+			for i := range 100 {
+				if snap[i] != i {
+					t.Fatalf("sequential appends out of order: %v", snap[:100])
+				}
+			}
+
+			if err := s.RemoveIndex(0); err != nil {
+				t.Fatal(err)
+			}
+			if v, _ := s.Get(0); v != 1 {
+				t.Fatalf("Get(0) = %d after RemoveIndex", v)
+			}
+			if err := s.Set(len(snap), 0); err == nil {
+				t.Fatal("Set out of range succeeded")
+			}
+		})
+	}
+}
+
+func TestSyncSliceOrderWithConcurrentReads(t *testing.T) {
+	const n = 20000
+	s := NewSyncSlice(WithAppendShards[int](8))
+	done := make(chan struct{})
+	var wg sync.WaitGroup
+	for range 4 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for {
+				select {
+				case <-done:
+					return
+				default:
+					s.Len()
+				}
+			}
+		}()
+	}
+	for i := range n {
+		s.Append(i)
+	}
+	close(done)
+	wg.Wait()
+
+	snap := s.Snapshot()
+	if len(snap) != n {
+		t.Fatalf("len = %d, want %d", len(snap), n)
+	}
+	for i, v := range snap {
+		if v != i {
+			t.Fatalf("snap[%d] = %d, appends of one goroutine got reordered", i, v)
+		}
+	}
+}
+
+// BenchmarkSyncSliceAppend compares concurrent appends in the mutex mode and
+// in the sharded mode. Run it with -cpu to see how the modes scale.
+func BenchmarkSyncSliceAppend(b *testing.B) {
+	for name, newSlice := range map[string]func() *SyncSlice[int]{
+		"mutex":   func() *SyncSlice[int] { return NewSyncSlice[int]() },
+		"sharded": func() *SyncSlice[int] { return NewSyncSlice(WithAppendShards[int](0)) },
+	} {
+		b.Run(name, func(b *testing.B) {
+			s := newSlice()
+			b.RunParallel(func(pb *testing.PB) {
+				for pb.Next() {
+					s.Append(1)
+				}
+			})
+		})
+	}
+}